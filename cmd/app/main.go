@@ -8,9 +8,11 @@ import (
 
 	"auth-service/config"
 	"auth-service/internal/database"
+	"auth-service/internal/infrastructure/security"
 	"auth-service/internal/repository"
 	"auth-service/internal/router"
 	"auth-service/internal/service"
+	"auth-service/utils"
 )
 
 func main() {
@@ -23,18 +25,34 @@ func main() {
 
 	database.Migrate(db)
 
+	keys, err := utils.NewKeyManager(cfg.KeyStoragePath, cfg.KeyRotationInterval)
+	if err != nil {
+		log.Fatalf("failed to initialize signing key manager: %v", err)
+	}
+
+	var denylist *utils.TokenDenylist
+	if cfg.RedisEnabled {
+		redisClient := utils.NewRedisClient(cfg.RedisHost, cfg.RedisPort, cfg.RedisPassword, cfg.RedisDB)
+		denylist = utils.NewTokenDenylist(redisClient)
+	}
+
 	userRepo := repository.NewUserRepository(db)
 	refreshRepo := repository.NewRefreshTokenRepository(db)
+	oauthClientRepo := repository.NewOAuthClientRepository(db)
+	passwordHasher := security.NewArgon2Hasher(security.DefaultArgon2Params)
 
 	authService := service.NewAuthService(
 		userRepo,
 		refreshRepo,
-		cfg.JWTSecret,
+		keys,
+		denylist,
+		passwordHasher,
 		cfg.AccessTokenTTL,
 		cfg.RefreshTokenTTL,
+		cfg.RefreshIdleTimeout,
 	)
 
-	r := router.SetupRouter(authService, cfg)
+	r := router.SetupRouter(authService, cfg, oauthClientRepo, passwordHasher)
 	log.Println("Auth Service running on port " + cfg.Port)
 	r.Run(":" + cfg.Port)
 }
@@ -9,8 +9,11 @@ import (
 	"syscall"
 
 	"auth-service/internal/application/usecase"
+	"auth-service/internal/infrastructure/audit"
 	"auth-service/internal/infrastructure/config"
 	"auth-service/internal/infrastructure/logger"
+	"auth-service/internal/infrastructure/mailer"
+	"auth-service/internal/infrastructure/oauthprovider"
 	"auth-service/internal/infrastructure/persistence/postgres"
 	"auth-service/internal/infrastructure/security"
 	"auth-service/internal/presentation/http/handler"
@@ -63,28 +66,183 @@ func main() {
 	userRepo := postgres.NewUserRepository(db)
 	refreshTokenRepo := postgres.NewRefreshTokenRepository(db)
 	auditLogRepo := postgres.NewAuditLogRepository(db)
+	clientRepo := postgres.NewClientRepository(db)
+	authCodeRepo := postgres.NewAuthorizationCodeRepository(db)
+	identityRepo := postgres.NewIdentityRepository(db)
+	userMFARepo := postgres.NewUserMFARepository(db)
+	mfaChallengeRepo := postgres.NewMFAChallengeRepository(db)
+	webauthnCredRepo := postgres.NewWebAuthnCredentialRepository(db)
+	webauthnChallengeRepo := postgres.NewWebAuthnChallengeRepository(db)
+	verificationRepo := postgres.NewVerificationTokenRepository(db)
+	roleRepo := postgres.NewRoleRepository(db)
+
+	if err := postgres.SeedDefaultRoles(db); err != nil {
+		log.Fatal("Failed to seed default roles", zap.Error(err))
+	}
 
 	jwtService := security.NewJWTService(cfg.JWT.Secret, cfg.JWT.AccessTokenTTL)
 	passwordService := security.NewPasswordService()
+	passwordHasher := security.NewArgon2Hasher(security.Argon2Params{
+		Memory:      cfg.Password.Argon2Memory,
+		Iterations:  cfg.Password.Argon2Iterations,
+		Parallelism: cfg.Password.Argon2Parallelism,
+		SaltLength:  security.DefaultArgon2Params.SaltLength,
+		KeyLength:   security.DefaultArgon2Params.KeyLength,
+	})
+	totpService := security.NewTOTPService()
+	qrCodeService := security.NewQRCodeService()
+
+	encryptionService, err := security.NewEncryptionService(cfg.MFA.EncryptionKey)
+	if err != nil {
+		log.Fatal("Failed to initialize encryption service", zap.Error(err))
+	}
+
+	oidcSigner, err := security.NewOIDCSigner(cfg.OIDC.RSAPrivateKeyPEM, cfg.OIDC.Issuer, "default", cfg.OIDC.KeyRotationGrace)
+	if err != nil {
+		log.Fatal("Failed to initialize OIDC signer", zap.Error(err))
+	}
+	jwtService.UseAsymmetricSigning(oidcSigner)
+
+	webauthnService, err := security.NewWebAuthnService(cfg.WebAuthn.RPID, cfg.WebAuthn.RPDisplayName, cfg.WebAuthn.RPOrigins)
+	if err != nil {
+		log.Fatal("Failed to initialize webauthn service", zap.Error(err))
+	}
+
+	var mailTransport mailer.Transport
+	if cfg.Mail.Transport == "smtp" {
+		mailTransport = mailer.NewSMTPTransport(mailer.SMTPConfig{
+			Host:     cfg.Mail.SMTPHost,
+			Port:     cfg.Mail.SMTPPort,
+			Username: cfg.Mail.SMTPUsername,
+			Password: cfg.Mail.SMTPPassword,
+			From:     cfg.Mail.From,
+		})
+	} else {
+		mailTransport = mailer.NewConsoleTransport(log)
+	}
+	mailService := mailer.NewMailer(mailTransport, cfg.Mail.From, cfg.Mail.AppBaseURL)
+
+	auditSinks := make([]audit.Sink, 0, len(cfg.Audit.Sinks))
+	for _, name := range cfg.Audit.Sinks {
+		switch name {
+		case "postgres":
+			auditSinks = append(auditSinks, audit.NewPostgresSink(auditLogRepo))
+		case "file":
+			auditSinks = append(auditSinks, audit.NewFileSink(cfg.Audit.FilePath))
+		case "webhook":
+			auditSinks = append(auditSinks, audit.NewWebhookSink(cfg.Audit.WebhookURL, cfg.Audit.WebhookSecret))
+		case "zap":
+			auditSinks = append(auditSinks, audit.NewZapSink(log))
+		case "kafka":
+			auditSinks = append(auditSinks, audit.NewKafkaSink(cfg.Audit.KafkaBrokers, cfg.Audit.KafkaTopic))
+		default:
+			log.Warn("audit: ignoring unknown sink", zap.String("sink", name))
+		}
+	}
+	auditDispatcher := audit.NewDispatcher(auditSinks, cfg.Audit.FlushSize, cfg.Audit.FlushInterval, cfg.Audit.BufferSize, log)
 
 	authUseCase := usecase.NewAuthUseCase(
 		userRepo,
 		refreshTokenRepo,
-		auditLogRepo,
+		auditDispatcher,
+		userMFARepo,
+		mfaChallengeRepo,
+		webauthnCredRepo,
+		webauthnChallengeRepo,
+		verificationRepo,
+		roleRepo,
 		jwtService,
 		passwordService,
+		passwordHasher,
+		totpService,
+		encryptionService,
+		qrCodeService,
+		webauthnService,
+		mailService,
 		usecase.AuthConfig{
-			AccessTokenTTL:      cfg.JWT.AccessTokenTTL,
-			RefreshTokenTTL:     cfg.JWT.RefreshTokenTTL,
-			MaxLoginAttempts:    cfg.Security.MaxLoginAttempts,
-			AccountLockDuration: cfg.Security.AccountLockDuration,
+			AccessTokenTTL:       cfg.JWT.AccessTokenTTL,
+			RefreshTokenTTL:      cfg.JWT.RefreshTokenTTL,
+			MaxLoginAttempts:     cfg.Security.MaxLoginAttempts,
+			AccountLockDuration:  cfg.Security.AccountLockDuration,
+			MFATokenTTL:          cfg.MFA.TokenTTL,
+			MFARecoveryCodes:     cfg.MFA.RecoveryCodes,
+			EmailVerificationTTL: cfg.Mail.VerificationTokenTTL,
+			PasswordResetTTL:     cfg.Mail.PasswordResetTokenTTL,
+			WebAuthnChallengeTTL: cfg.WebAuthn.ChallengeTTL,
+			SensitiveOpTTL:       cfg.MFA.SensitiveOpTTL,
 		},
 	)
 
+	oauthUseCase := usecase.NewOAuthUseCase(
+		clientRepo,
+		authCodeRepo,
+		refreshTokenRepo,
+		userRepo,
+		jwtService,
+		oidcSigner,
+		passwordHasher,
+		usecase.OAuthConfig{
+			AuthCodeTTL:     cfg.OIDC.AuthCodeTTL,
+			IDTokenTTL:      cfg.OIDC.IDTokenTTL,
+			RefreshTokenTTL: cfg.JWT.RefreshTokenTTL,
+		},
+	)
+
+	loginProviders := map[string]usecase.LoginProvider{}
+	if cfg.Providers.Google.ClientID != "" {
+		loginProviders["google"] = oauthprovider.NewAdapter(oauthprovider.NewGoogleProvider(oauthprovider.Config{
+			ClientID:     cfg.Providers.Google.ClientID,
+			ClientSecret: cfg.Providers.Google.ClientSecret,
+			RedirectURL:  cfg.Providers.Google.RedirectURL,
+		}))
+	}
+	if cfg.Providers.GitHub.ClientID != "" {
+		loginProviders["github"] = oauthprovider.NewAdapter(oauthprovider.NewGitHubProvider(oauthprovider.Config{
+			ClientID:     cfg.Providers.GitHub.ClientID,
+			ClientSecret: cfg.Providers.GitHub.ClientSecret,
+			RedirectURL:  cfg.Providers.GitHub.RedirectURL,
+		}))
+	}
+	if cfg.Providers.GenericIssuer != "" {
+		genericProvider, err := oauthprovider.NewOIDCProvider(context.Background(), cfg.Providers.GenericIssuer, oauthprovider.Config{
+			ClientID:     cfg.Providers.GenericOIDC.ClientID,
+			ClientSecret: cfg.Providers.GenericOIDC.ClientSecret,
+			RedirectURL:  cfg.Providers.GenericOIDC.RedirectURL,
+		})
+		if err != nil {
+			log.Fatal("Failed to initialize generic OIDC provider", zap.Error(err))
+		}
+		loginProviders["oidc"] = oauthprovider.NewAdapter(genericProvider)
+	}
+
+	stateSigner := security.NewStateSigner(cfg.Providers.StateSecret, cfg.Providers.StateTTL)
+
+	identityUseCase := usecase.NewIdentityUseCase(
+		userRepo,
+		identityRepo,
+		refreshTokenRepo,
+		auditLogRepo,
+		roleRepo,
+		jwtService,
+		stateSigner,
+		loginProviders,
+		usecase.AuthConfig{
+			AccessTokenTTL:  cfg.JWT.AccessTokenTTL,
+			RefreshTokenTTL: cfg.JWT.RefreshTokenTTL,
+		},
+	)
+
+	roleUseCase := usecase.NewRoleUseCase(roleRepo, auditLogRepo)
+	auditLogUseCase := usecase.NewAuditLogUseCase(auditLogRepo)
+
 	authHandler := handler.NewAuthHandler(authUseCase, cfg, log)
 	healthHandler := handler.NewHealthHandler(db)
+	oauthHandler := handler.NewOAuthHandler(oauthUseCase, oidcSigner, cfg, log)
+	identityHandler := handler.NewIdentityHandler(identityUseCase, cfg, log)
+	roleHandler := handler.NewRoleHandler(roleUseCase, cfg, log)
+	auditLogHandler := handler.NewAuditLogHandler(auditLogUseCase, cfg, log)
 
-	r := router.NewRouter(authHandler, healthHandler, jwtService, cfg, log)
+	r := router.NewRouter(authHandler, healthHandler, oauthHandler, identityHandler, roleHandler, auditLogHandler, jwtService, cfg, log)
 	engine := r.Setup()
 
 	srv := &http.Server{
@@ -117,6 +275,10 @@ func main() {
 		log.Fatal("Server forced to shutdown", zap.Error(err))
 	}
 
+	if err := auditDispatcher.Shutdown(ctx); err != nil {
+		log.Error("Failed to flush pending audit events", zap.Error(err))
+	}
+
 	sqlDB, _ := db.DB()
 	if err := sqlDB.Close(); err != nil {
 		log.Error("Failed to close database connection", zap.Error(err))
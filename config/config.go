@@ -9,32 +9,52 @@ import (
 )
 
 type Config struct {
-	DBUrl     string
-	JWTSecret string
-	Port      string
-
-	AccessTokenTTL    time.Duration
-	RefreshTokenTTL   time.Duration
-	RefreshCookieName string
-	CookieDomain      string
-	CookieSecure      bool
-	CookieSameSite    string
+	DBUrl string
+	Port  string
+
+	JWTAlgorithm        string
+	KeyStoragePath      string
+	KeyRotationInterval time.Duration
+
+	AccessTokenTTL     time.Duration
+	RefreshTokenTTL    time.Duration // hard cap on a refresh token family's lifetime
+	RefreshIdleTimeout time.Duration // sliding expiry extension granted on each use, capped by RefreshTokenTTL
+	RefreshCookieName  string
+	CookieDomain       string
+	CookieSecure       bool
+	CookieSameSite     string
+
+	RedisEnabled  bool
+	RedisHost     string
+	RedisPort     string
+	RedisPassword string
+	RedisDB       int
 }
 
 func LoadConfig() *Config {
 	_ = godotenv.Load()
 
 	cfg := &Config{
-		DBUrl:     os.Getenv("DATABASE_URL"),
-		JWTSecret: os.Getenv("JWT_SECRET"),
-		Port:      os.Getenv("PORT"),
-
-		AccessTokenTTL:    parseDurationWithDefault(os.Getenv("ACCESS_TOKEN_TTL"), 15*time.Minute),
-		RefreshTokenTTL:   parseDurationWithDefault(os.Getenv("REFRESH_TOKEN_TTL"), 720*time.Hour), // 30 ngày
-		RefreshCookieName: firstNonEmpty(os.Getenv("REFRESH_COOKIE_NAME"), "refresh_token"),
-		CookieDomain:      os.Getenv("COOKIE_DOMAIN"),
-		CookieSecure:      parseBoolWithDefault(os.Getenv("COOKIE_SECURE"), false),
-		CookieSameSite:    firstNonEmpty(os.Getenv("COOKIE_SAMESITE"), "Lax"),
+		DBUrl: os.Getenv("DATABASE_URL"),
+		Port:  os.Getenv("PORT"),
+
+		JWTAlgorithm:        firstNonEmpty(os.Getenv("JWT_ALGORITHM"), "RS256"),
+		KeyStoragePath:      firstNonEmpty(os.Getenv("JWT_KEY_STORAGE_PATH"), "./keys/jwt_signing_key.pem"),
+		KeyRotationInterval: parseDurationWithDefault(os.Getenv("JWT_KEY_ROTATION_INTERVAL"), 720*time.Hour),
+
+		AccessTokenTTL:     parseDurationWithDefault(os.Getenv("ACCESS_TOKEN_TTL"), 15*time.Minute),
+		RefreshTokenTTL:    parseDurationWithDefault(os.Getenv("REFRESH_TOKEN_TTL"), 720*time.Hour), // 30 ngày
+		RefreshIdleTimeout: parseDurationWithDefault(os.Getenv("REFRESH_IDLE_TIMEOUT"), 24*time.Hour),
+		RefreshCookieName:  firstNonEmpty(os.Getenv("REFRESH_COOKIE_NAME"), "refresh_token"),
+		CookieDomain:       os.Getenv("COOKIE_DOMAIN"),
+		CookieSecure:       parseBoolWithDefault(os.Getenv("COOKIE_SECURE"), false),
+		CookieSameSite:     firstNonEmpty(os.Getenv("COOKIE_SAMESITE"), "Lax"),
+
+		RedisEnabled:  parseBoolWithDefault(os.Getenv("REDIS_ENABLED"), false),
+		RedisHost:     firstNonEmpty(os.Getenv("REDIS_HOST"), "localhost"),
+		RedisPort:     firstNonEmpty(os.Getenv("REDIS_PORT"), "6379"),
+		RedisPassword: os.Getenv("REDIS_PASSWORD"),
+		RedisDB:       parseIntWithDefault(os.Getenv("REDIS_DB"), 0),
 	}
 
 	return cfg
@@ -47,6 +67,13 @@ func parseDurationWithDefault(s string, defaultDur time.Duration) time.Duration
 	return defaultDur
 }
 
+func parseIntWithDefault(s string, def int) int {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	return def
+}
+
 func parseBoolWithDefault(s string, def bool) bool {
 	if s == "" {
 		return def
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// AuditLogDTO is the wire representation of an audit log entry returned by
+// the admin audit-log API.
+type AuditLogDTO struct {
+	ID        string                 `json:"id"`
+	UserID    string                 `json:"user_id"`
+	Action    string                 `json:"action"`
+	IPAddress string                 `json:"ip_address,omitempty"`
+	UserAgent string                 `json:"user_agent,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// AuditLogSearchRequest is the compound filter body accepted by
+// POST /admin/audit-logs/search, and the parsed form of the query
+// parameters GET /admin/audit-logs and /admin/audit-logs.csv accept.
+type AuditLogSearchRequest struct {
+	UserID    string     `json:"user_id"`
+	Action    string     `json:"action"`
+	IPAddress string     `json:"ip_address"`
+	From      *time.Time `json:"from"`
+	To        *time.Time `json:"to"`
+	Cursor    string     `json:"cursor"`
+	Limit     int        `json:"limit"`
+}
+
+type AuditLogListResponse struct {
+	Logs       []AuditLogDTO `json:"logs"`
+	Total      int64         `json:"total"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type IdentityDTO struct {
+	Provider  string `json:"provider"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+// EnrollTOTPResponse carries the provisioning data the client needs to add
+// the account to an authenticator app. The secret and recovery codes are
+// only ever returned here, at enrollment time.
+type EnrollTOTPResponse struct {
+	Secret        string   `json:"secret"`
+	OTPAuthURL    string   `json:"otpauth_url"`
+	QRCodePNG     string   `json:"qr_code_png,omitempty"` // base64-encoded PNG of OTPAuthURL
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type ConfirmTOTPRequest struct {
+	Code string `json:"code" binding:"required,len=6"`
+}
+
+type DisableTOTPRequest struct {
+	Password    string `json:"password" binding:"required"`
+	ReauthToken string `json:"reauth_token" binding:"required"`
+}
+
+type VerifyMFARequest struct {
+	MFAToken     string `json:"mfa_token" binding:"required"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+// ReauthenticateRequest confirms the caller still controls the account
+// before a sensitive operation, the same way Login does but without
+// issuing a session - just a short-lived ReauthToken those operations
+// require. Code is only checked when the account has TOTP enabled.
+type ReauthenticateRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code"`
+}
+
+type ReauthenticateResponse struct {
+	ReauthToken string `json:"reauth_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
@@ -0,0 +1,54 @@
+package dto
+
+// AuthorizeRequest models the query parameters of GET /oauth2/authorize.
+type AuthorizeRequest struct {
+	ClientID            string `form:"client_id" binding:"required"`
+	RedirectURI         string `form:"redirect_uri" binding:"required"`
+	ResponseType        string `form:"response_type" binding:"required"`
+	Scope               string `form:"scope"`
+	State               string `form:"state"`
+	Nonce               string `form:"nonce"`
+	CodeChallenge       string `form:"code_challenge" binding:"required"`
+	CodeChallengeMethod string `form:"code_challenge_method" binding:"required"`
+}
+
+type AuthorizeResponse struct {
+	RedirectURI string
+	Code        string
+	State       string
+}
+
+// TokenRequest models the form body of POST /oauth2/token, for either the
+// authorization_code grant (Code/RedirectURI/CodeVerifier) or the
+// refresh_token grant (RefreshToken).
+type TokenRequest struct {
+	GrantType    string `form:"grant_type" binding:"required"`
+	Code         string `form:"code"`
+	RedirectURI  string `form:"redirect_uri"`
+	ClientID     string `form:"client_id" binding:"required"`
+	ClientSecret string `form:"client_secret"`
+	CodeVerifier string `form:"code_verifier"`
+	RefreshToken string `form:"refresh_token"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	IDToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope"`
+}
+
+type UserInfoResponse struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email"`
+}
+
+type IntrospectResponse struct {
+	Active   bool   `json:"active"`
+	Subject  string `json:"sub,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+	Exp      int64  `json:"exp,omitempty"`
+}
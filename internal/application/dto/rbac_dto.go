@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// RoleDTO is the wire representation of a role definition returned by the
+// admin roles API.
+type RoleDTO struct {
+	Name        string    `json:"name"`
+	Parent      string    `json:"parent,omitempty"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	Parent      string   `json:"parent"`
+	Permissions []string `json:"permissions"`
+}
+
+type DeleteRoleRequest struct {
+	Name string `json:"name" binding:"required"`
+}
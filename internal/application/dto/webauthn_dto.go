@@ -0,0 +1,42 @@
+package dto
+
+import "encoding/json"
+
+// BeginWebAuthnCeremonyResponse carries the options blob a ceremony needs
+// for navigator.credentials.create()/.get(), verbatim from the WebAuthn
+// library, alongside the ChallengeID the matching Finish call must echo
+// back so the server can look up its session data.
+type BeginWebAuthnCeremonyResponse struct {
+	ChallengeID string          `json:"challenge_id"`
+	Options     json.RawMessage `json:"options"`
+}
+
+// BeginWebAuthnLoginRequest carries the login completion ticket Login
+// issued after a successful password check, proving this ceremony is
+// completing that same login rather than starting an independent one.
+type BeginWebAuthnLoginRequest struct {
+	Ticket string `json:"ticket" binding:"required"`
+}
+
+// FinishWebAuthnRequest wraps the raw attestation/assertion response the
+// browser produced, passed through unparsed to the WebAuthn library.
+type FinishWebAuthnRequest struct {
+	ChallengeID string          `json:"challenge_id" binding:"required"`
+	Response    json.RawMessage `json:"response" binding:"required"`
+}
+
+// FinishWebAuthnLoginRequest is FinishWebAuthnRequest plus the same login
+// completion ticket BeginWebAuthnLoginRequest carried, so the Finish step
+// can re-check the assertion was for the user that ticket names.
+type FinishWebAuthnLoginRequest struct {
+	Ticket      string          `json:"ticket" binding:"required"`
+	ChallengeID string          `json:"challenge_id" binding:"required"`
+	Response    json.RawMessage `json:"response" binding:"required"`
+}
+
+type WebAuthnCredentialDTO struct {
+	ID              string   `json:"id"`
+	AttestationType string   `json:"attestation_type"`
+	Transports      []string `json:"transports"`
+	CreatedAt       string   `json:"created_at"`
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// AuditLogUseCase exposes read access to the audit trail for the admin API.
+// Writes go through the individual feature usecases (AuthUseCase,
+// IdentityUseCase, RoleUseCase, ...), each of which best-effort-creates its
+// own entries.
+type AuditLogUseCase struct {
+	auditRepo repository.AuditLogRepository
+}
+
+func NewAuditLogUseCase(auditRepo repository.AuditLogRepository) *AuditLogUseCase {
+	return &AuditLogUseCase{auditRepo: auditRepo}
+}
+
+// List resolves a search request into a repository filter, fetches the
+// matching page, and reports the cursor for the next one.
+func (uc *AuditLogUseCase) List(ctx context.Context, req dto.AuditLogSearchRequest) (*dto.AuditLogListResponse, error) {
+	filter, err := toAuditLogFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, total, err := uc.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuditLogListResponse{
+		Logs:       toAuditLogDTOs(logs),
+		Total:      total,
+		NextCursor: nextAuditLogCursor(logs, filter.Limit),
+	}, nil
+}
+
+// Stream fetches every page matching req, invoking emit for each entry in
+// newest-first order, so callers (the CSV export handler) never have to
+// hold the full result set in memory.
+func (uc *AuditLogUseCase) Stream(ctx context.Context, req dto.AuditLogSearchRequest, emit func(*entity.AuditLog) error) error {
+	filter, err := toAuditLogFilter(req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		logs, _, err := uc.auditRepo.List(ctx, filter)
+		if err != nil {
+			return err
+		}
+		for _, log := range logs {
+			if err := emit(log); err != nil {
+				return err
+			}
+		}
+		if len(logs) < filter.Limit {
+			return nil
+		}
+		filter.Cursor = entity.EncodeAuditLogCursor(logs[len(logs)-1])
+	}
+}
+
+func toAuditLogFilter(req dto.AuditLogSearchRequest) (repository.AuditLogFilter, error) {
+	filter := repository.AuditLogFilter{
+		IPAddress: req.IPAddress,
+		From:      req.From,
+		To:        req.To,
+		Cursor:    req.Cursor,
+		Limit:     req.Limit,
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return repository.AuditLogFilter{}, domainErr.ErrInvalidInput
+		}
+		filter.UserID = &userID
+	}
+
+	if req.Action != "" {
+		action := entity.AuditAction(req.Action)
+		filter.Action = &action
+	}
+
+	return filter, nil
+}
+
+func toAuditLogDTOs(logs []*entity.AuditLog) []dto.AuditLogDTO {
+	result := make([]dto.AuditLogDTO, len(logs))
+	for i, log := range logs {
+		result[i] = dto.AuditLogDTO{
+			ID:        log.ID.String(),
+			UserID:    log.UserID.String(),
+			Action:    string(log.Action),
+			IPAddress: log.IPAddress,
+			UserAgent: log.UserAgent,
+			Metadata:  log.Metadata,
+			CreatedAt: log.CreatedAt,
+		}
+	}
+	return result
+}
+
+func nextAuditLogCursor(logs []*entity.AuditLog, limit int) string {
+	if len(logs) < limit {
+		return ""
+	}
+	return entity.EncodeAuditLogCursor(logs[len(logs)-1])
+}
@@ -1,59 +1,161 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"auth-service/internal/application/dto"
 	"auth-service/internal/domain/entity"
 	domainErr "auth-service/internal/domain/error"
 	"auth-service/internal/domain/repository"
+	domainSecurity "auth-service/internal/domain/security"
+	"auth-service/internal/infrastructure/logger"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type AuthUseCase struct {
-	userRepo        repository.UserRepository
-	refreshRepo     repository.RefreshTokenRepository
-	auditRepo       repository.AuditLogRepository
-	tokenService    TokenService
-	passwordService PasswordService
-	config          AuthConfig
+	userRepo              repository.UserRepository
+	refreshRepo           repository.RefreshTokenRepository
+	auditRecorder         AuditRecorder
+	mfaRepo               repository.UserMFARepository
+	mfaChallengeRepo      repository.MFAChallengeRepository
+	webauthnCredRepo      repository.WebAuthnCredentialRepository
+	webauthnChallengeRepo repository.WebAuthnChallengeRepository
+	verificationRepo      repository.VerificationTokenRepository
+	roleRepo              repository.RoleRepository
+	tokenService          TokenService
+	passwordService       PasswordService
+	passwordHasher        domainSecurity.PasswordHasher
+	totpService           TOTPService
+	encryptor             Encryptor
+	qrEncoder             QRCodeEncoder
+	webauthn              WebAuthnService
+	mailer                Mailer
+	config                AuthConfig
 }
 
 type AuthConfig struct {
-	AccessTokenTTL      time.Duration
-	RefreshTokenTTL     time.Duration
-	MaxLoginAttempts    int
-	AccountLockDuration time.Duration
+	AccessTokenTTL       time.Duration
+	RefreshTokenTTL      time.Duration
+	MaxLoginAttempts     int
+	AccountLockDuration  time.Duration
+	MFATokenTTL          time.Duration
+	MFARecoveryCodes     int
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+	WebAuthnChallengeTTL time.Duration
+	SensitiveOpTTL       time.Duration
 }
 
 type TokenService interface {
 	GenerateAccessToken(userID string) (string, error)
+	GenerateAccessTokenWithClaims(userID string, roles, permissions []string) (string, error)
 	GenerateRefreshToken() (plain, hash string, err error)
 	HashToken(plain string) string
-	ValidateAccessToken(token string) (userID string, err error)
+	ValidateAccessToken(token string) (*entity.Principal, error)
+	GenerateSensitiveOpToken(userID string, ttl time.Duration) (string, error)
+	ValidateSensitiveOpToken(token string) (string, error)
+	GenerateLoginCompletionTicket(userID string, ttl time.Duration) (string, error)
+	ValidateLoginCompletionTicket(ticket string) (string, error)
 }
 
 type PasswordService interface {
 	ValidateStrength(password string) error
 }
 
+// TOTPService generates and verifies RFC 6238 time-based one-time codes.
+type TOTPService interface {
+	GenerateSecret() (string, error)
+	Verify(secret, code string, lastUsedCounter int64) (matchedCounter int64, ok bool)
+}
+
+// Encryptor encrypts TOTP secrets at rest.
+type Encryptor interface {
+	Encrypt(plaintext string) (string, error)
+	Decrypt(ciphertext string) (string, error)
+}
+
+// QRCodeEncoder renders provisioning data so an authenticator app can scan
+// it instead of the user transcribing the secret by hand.
+type QRCodeEncoder interface {
+	EncodePNG(content string, size int) ([]byte, error)
+}
+
+// WebAuthnService runs WebAuthn registration and login ceremonies. Begin*
+// calls return the options blob for the browser plus opaque sessionData
+// that the matching Finish* call must receive back unchanged. Finish*
+// results are returned as plain fields rather than an infrastructure type,
+// so AuthUseCase depends only on this interface.
+type WebAuthnService interface {
+	BeginRegistration(userID uuid.UUID, email string, existing []*entity.WebAuthnCredential) (optionsJSON, sessionData []byte, err error)
+	FinishRegistration(existing []*entity.WebAuthnCredential, userID uuid.UUID, email string, sessionData, rawResponse []byte) (credentialID, publicKey []byte, attestationType string, transports []string, aaguid []byte, signCount uint32, err error)
+	BeginLogin(userID uuid.UUID, email string, existing []*entity.WebAuthnCredential) (optionsJSON, sessionData []byte, err error)
+	FinishLogin(existing []*entity.WebAuthnCredential, userID uuid.UUID, email string, sessionData, rawResponse []byte) (credentialID []byte, newSignCount uint32, err error)
+}
+
+// AuditRecorder accepts an audit event for durable delivery without making
+// the caller wait on it - implementations buffer and flush asynchronously,
+// so Record should never block on I/O.
+type AuditRecorder interface {
+	Record(ctx context.Context, log *entity.AuditLog)
+}
+
+// Mailer sends the transactional emails the verification and password-reset
+// flows depend on, through whatever transport (SMTP, console) is wired up.
+type Mailer interface {
+	SendVerificationEmail(ctx context.Context, to, token string) error
+	SendPasswordResetEmail(ctx context.Context, to, token string) error
+}
+
 func NewAuthUseCase(
 	userRepo repository.UserRepository,
 	refreshRepo repository.RefreshTokenRepository,
-	auditRepo repository.AuditLogRepository,
+	auditRecorder AuditRecorder,
+	mfaRepo repository.UserMFARepository,
+	mfaChallengeRepo repository.MFAChallengeRepository,
+	webauthnCredRepo repository.WebAuthnCredentialRepository,
+	webauthnChallengeRepo repository.WebAuthnChallengeRepository,
+	verificationRepo repository.VerificationTokenRepository,
+	roleRepo repository.RoleRepository,
 	tokenService TokenService,
 	passwordService PasswordService,
+	passwordHasher domainSecurity.PasswordHasher,
+	totpService TOTPService,
+	encryptor Encryptor,
+	qrEncoder QRCodeEncoder,
+	webauthn WebAuthnService,
+	mailer Mailer,
 	config AuthConfig,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:        userRepo,
-		refreshRepo:     refreshRepo,
-		auditRepo:       auditRepo,
-		tokenService:    tokenService,
-		passwordService: passwordService,
-		config:          config,
+		userRepo:              userRepo,
+		refreshRepo:           refreshRepo,
+		auditRecorder:         auditRecorder,
+		mfaRepo:               mfaRepo,
+		mfaChallengeRepo:      mfaChallengeRepo,
+		webauthnCredRepo:      webauthnCredRepo,
+		webauthnChallengeRepo: webauthnChallengeRepo,
+		verificationRepo:      verificationRepo,
+		roleRepo:              roleRepo,
+		tokenService:          tokenService,
+		passwordService:       passwordService,
+		passwordHasher:        passwordHasher,
+		totpService:           totpService,
+		encryptor:             encryptor,
+		qrEncoder:             qrEncoder,
+		webauthn:              webauthn,
+		mailer:                mailer,
+		config:                config,
 	}
 }
 
@@ -70,7 +172,7 @@ func (uc *AuthUseCase) Register(ctx context.Context, req dto.RegisterRequest) er
 		return err
 	}
 
-	user, err := entity.NewUser(req.Email, req.Password)
+	user, err := entity.NewUser(req.Email, req.Password, uc.passwordHasher)
 	if err != nil {
 		return err
 	}
@@ -79,8 +181,160 @@ func (uc *AuthUseCase) Register(ctx context.Context, req dto.RegisterRequest) er
 		return err
 	}
 
+	if err := uc.roleRepo.AssignToUser(ctx, user.ID, entity.DefaultRoleUser); err != nil {
+		return err
+	}
+
 	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionRegister, "", "")
-	_ = uc.auditRepo.Create(ctx, auditLog)
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	logger.FromContext(ctx).Info("user registered", zap.String("user_id", user.ID.String()))
+
+	// Sending the verification email is best-effort: a flaky mail transport
+	// shouldn't fail registration, and ResendVerificationEmail covers retry.
+	_ = uc.sendVerificationEmail(ctx, user)
+
+	return nil
+}
+
+// sendVerificationEmail issues a fresh email-verification token, retiring
+// any earlier unconsumed one, and mails it to the user.
+func (uc *AuthUseCase) sendVerificationEmail(ctx context.Context, user *entity.User) error {
+	plain, hash, err := uc.tokenService.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	_ = uc.verificationRepo.InvalidateByUserIDAndPurpose(ctx, user.ID, entity.VerificationPurposeEmailVerify)
+
+	token := entity.NewVerificationToken(user.ID, entity.VerificationPurposeEmailVerify, hash, uc.config.EmailVerificationTTL)
+	if err := uc.verificationRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+	return uc.mailer.SendVerificationEmail(ctx, user.Email, plain)
+}
+
+// ResendVerificationEmail re-sends the verification link for an unverified
+// account. It does not reveal whether the address is registered, to avoid
+// turning this endpoint into an account-enumeration oracle.
+func (uc *AuthUseCase) ResendVerificationEmail(ctx context.Context, email string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+	if user.IsVerified {
+		return domainErr.ErrEmailAlreadyVerified
+	}
+
+	return uc.sendVerificationEmail(ctx, user)
+}
+
+// VerifyEmail redeems a token mailed by sendVerificationEmail, marking the
+// account verified.
+func (uc *AuthUseCase) VerifyEmail(ctx context.Context, tokenPlain string) error {
+	if tokenPlain == "" {
+		return domainErr.ErrMissingToken
+	}
+
+	tokenHash := uc.tokenService.HashToken(tokenPlain)
+
+	token, err := uc.verificationRepo.FindByTokenHash(ctx, tokenHash)
+	if err != nil || token.Purpose != entity.VerificationPurposeEmailVerify {
+		return domainErr.ErrInvalidToken
+	}
+	if !token.IsValid() {
+		return domainErr.ErrTokenExpired
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+
+	user.Verify()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+	_ = uc.verificationRepo.MarkConsumed(ctx, token.ID)
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionEmailVerification, "", "")
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return nil
+}
+
+// ForgotPassword mails a password-reset link when the address belongs to an
+// account, and otherwise silently does nothing - the caller always sees
+// success so the endpoint can't be used to enumerate accounts.
+func (uc *AuthUseCase) ForgotPassword(ctx context.Context, email string) error {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil
+	}
+
+	plain, hash, err := uc.tokenService.GenerateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	_ = uc.verificationRepo.InvalidateByUserIDAndPurpose(ctx, user.ID, entity.VerificationPurposePasswordReset)
+
+	token := entity.NewVerificationToken(user.ID, entity.VerificationPurposePasswordReset, hash, uc.config.PasswordResetTTL)
+	if err := uc.verificationRepo.Create(ctx, token); err != nil {
+		return err
+	}
+
+	return uc.mailer.SendPasswordResetEmail(ctx, user.Email, plain)
+}
+
+// ResetPassword redeems a token mailed by ForgotPassword, sets the new
+// password, and revokes every outstanding session the same way
+// ChangePassword does.
+func (uc *AuthUseCase) ResetPassword(ctx context.Context, tokenPlain, newPassword string) error {
+	if tokenPlain == "" {
+		return domainErr.ErrMissingToken
+	}
+
+	tokenHash := uc.tokenService.HashToken(tokenPlain)
+
+	token, err := uc.verificationRepo.FindByTokenHash(ctx, tokenHash)
+	if err != nil || token.Purpose != entity.VerificationPurposePasswordReset {
+		return domainErr.ErrInvalidToken
+	}
+	if !token.IsValid() {
+		return domainErr.ErrTokenExpired
+	}
+
+	if err := uc.passwordService.ValidateStrength(newPassword); err != nil {
+		return err
+	}
+
+	// Claim the token before touching the password: this is the atomic
+	// compare-and-set that decides which of two concurrent requests for the
+	// same token wins, closing the TOCTOU window the earlier IsValid check
+	// alone left open between validating and consuming it.
+	if err := uc.verificationRepo.MarkConsumed(ctx, token.ID); err != nil {
+		return domainErr.ErrInvalidToken
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+
+	if err := user.ChangePassword(newPassword, uc.passwordHasher); err != nil {
+		return err
+	}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	_ = uc.refreshRepo.RevokeAllByUserID(ctx, user.ID)
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionPasswordReset, "", "")
+	uc.auditRecorder.Record(ctx, auditLog)
+	logger.FromContext(ctx).Info("password reset via token", zap.String("user_id", user.ID.String()))
 
 	return nil
 }
@@ -90,49 +344,548 @@ func (uc *AuthUseCase) Login(ctx context.Context, req dto.LoginRequest, ipAddres
 	if err != nil {
 		auditLog := entity.NewAuditLog(entity.User{}.ID, entity.AuditActionLoginFailed, ipAddress, userAgent)
 		auditLog.AddMetadata("email", req.Email)
-		_ = uc.auditRepo.Create(ctx, auditLog)
+		uc.auditRecorder.Record(ctx, auditLog)
+		logger.FromContext(ctx).Warn("login failed: unknown email", zap.String("email", req.Email))
 		return nil, domainErr.ErrInvalidCredentials
 	}
 
 	if user.IsAccountLocked() {
 		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionAccountLocked, ipAddress, userAgent)
-		_ = uc.auditRepo.Create(ctx, auditLog)
+		uc.auditRecorder.Record(ctx, auditLog)
+		logger.FromContext(ctx).Warn("login rejected: account locked", zap.String("user_id", user.ID.String()))
 		return nil, domainErr.ErrAccountLocked
 	}
 
-	if err := user.VerifyPassword(req.Password); err != nil {
+	if err := user.VerifyPassword(req.Password, uc.passwordHasher); err != nil {
 		user.IncrementFailedLoginAttempts(uc.config.MaxLoginAttempts, uc.config.AccountLockDuration)
 		_ = uc.userRepo.Update(ctx, user)
 
 		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLoginFailed, ipAddress, userAgent)
-		_ = uc.auditRepo.Create(ctx, auditLog)
+		uc.auditRecorder.Record(ctx, auditLog)
+		logger.FromContext(ctx).Warn("login failed: bad password", zap.String("user_id", user.ID.String()))
 
 		return nil, domainErr.ErrInvalidCredentials
 	}
 
+	// A verified password proves the plaintext, so this is also the one
+	// place a stale bcrypt or under-strength Argon2id hash can be upgraded
+	// without asking the user to reset it.
+	if uc.passwordHasher.NeedsRehash(user.PasswordHash) {
+		if err := user.RehashPassword(req.Password, uc.passwordHasher); err != nil {
+			return nil, err
+		}
+	}
+
 	user.ResetFailedLoginAttempts()
 	user.UpdateLastLogin(ipAddress)
 	if err := uc.userRepo.Update(ctx, user); err != nil {
 		return nil, err
 	}
 
-	accessToken, err := uc.tokenService.GenerateAccessToken(user.ID.String())
+	if mfa, err := uc.mfaRepo.FindByUserID(ctx, user.ID); err == nil && mfa.Enabled {
+		mfaTokenPlain, mfaTokenHash, err := uc.tokenService.GenerateRefreshToken()
+		if err != nil {
+			return nil, err
+		}
+
+		challenge := entity.NewMFAChallenge(user.ID, mfaTokenHash, uc.config.MFATokenTTL)
+		if err := uc.mfaChallengeRepo.Create(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		return &dto.LoginResponse{MFARequired: true, MFAToken: mfaTokenPlain, MFAMethod: "totp"}, nil
+	}
+
+	if creds, err := uc.webauthnCredRepo.FindByUserID(ctx, user.ID); err == nil && len(creds) > 0 {
+		ticket, err := uc.tokenService.GenerateLoginCompletionTicket(user.ID.String(), uc.config.MFATokenTTL)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.LoginResponse{MFARequired: true, MFAToken: ticket, MFAMethod: "webauthn"}, nil
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLogin, ipAddress, userAgent)
+	uc.auditRecorder.Record(ctx, auditLog)
+	logger.FromContext(ctx).Info("user logged in", zap.String("user_id", user.ID.String()))
+
+	return uc.issueTokenPair(ctx, user, ipAddress, userAgent)
+}
+
+// VerifyTOTP redeems an MFA challenge issued by Login, accepting either a
+// current TOTP code or an unused recovery code, and completes the login by
+// issuing a real token pair.
+func (uc *AuthUseCase) VerifyTOTP(ctx context.Context, req dto.VerifyMFARequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	challengeHash := uc.tokenService.HashToken(req.MFAToken)
+
+	challenge, err := uc.mfaChallengeRepo.FindByTokenHash(ctx, challengeHash)
+	if err != nil {
+		return nil, domainErr.ErrInvalidMFAToken
+	}
+	if !challenge.IsValid() {
+		return nil, domainErr.ErrInvalidMFAToken
+	}
+
+	mfa, err := uc.mfaRepo.FindByUserID(ctx, challenge.UserID)
+	if err != nil || !mfa.Enabled {
+		return nil, domainErr.ErrMFANotEnabled
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	verified := false
+	if req.RecoveryCode != "" {
+		if mfa.ConsumeRecoveryCode(req.RecoveryCode) {
+			verified = true
+		}
+	} else {
+		secret, err := uc.encryptor.Decrypt(mfa.SecretEncrypted)
+		if err != nil {
+			return nil, err
+		}
+		if counter, ok := uc.totpService.Verify(secret, req.Code, mfa.LastUsedCounter); ok {
+			mfa.AcceptCounter(counter)
+			verified = true
+		}
+	}
+
+	if !verified {
+		auditLog := entity.NewAuditLog(challenge.UserID, entity.AuditActionMFAChallengeFailed, ipAddress, userAgent)
+		uc.auditRecorder.Record(ctx, auditLog)
+		return nil, domainErr.ErrInvalidMFACode
+	}
+
+	if err := uc.mfaRepo.Update(ctx, mfa); err != nil {
+		return nil, err
+	}
+	_ = uc.mfaChallengeRepo.MarkConsumed(ctx, challenge.ID)
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLogin, ipAddress, userAgent)
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return uc.issueTokenPair(ctx, user, ipAddress, userAgent)
+}
+
+// EnrollTOTP generates a new TOTP secret and a batch of recovery codes for
+// the user. The MFA record starts unconfirmed; ConfirmTOTP must verify a
+// code from the authenticator app before it takes effect on Login.
+func (uc *AuthUseCase) EnrollTOTP(ctx context.Context, userID string) (*dto.EnrollTOTPResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	if existing, err := uc.mfaRepo.FindByUserID(ctx, userUUID); err == nil {
+		if existing.Enabled {
+			return nil, domainErr.ErrMFAAlreadyEnabled
+		}
+		// Re-enrolling before confirming replaces the pending secret rather
+		// than hitting the unique(user_id) constraint on a second Create.
+		if err := uc.mfaRepo.Delete(ctx, userUUID); err != nil {
+			return nil, err
+		}
+	}
+
+	secret, err := uc.totpService.GenerateSecret()
 	if err != nil {
 		return nil, err
 	}
 
-	refreshPlain, refreshHash, err := uc.tokenService.GenerateRefreshToken()
+	secretEncrypted, err := uc.encryptor.Encrypt(secret)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, uc.config.RefreshTokenTTL)
-	if err := uc.refreshRepo.Create(ctx, refreshToken); err != nil {
+	recoveryCodes := make([]string, uc.config.MFARecoveryCodes)
+	recoveryCodeHashes := make([]string, uc.config.MFARecoveryCodes)
+	for i := range recoveryCodes {
+		plain, hash, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		recoveryCodes[i] = plain
+		recoveryCodeHashes[i] = hash
+	}
+
+	mfa := entity.NewUserMFA(userUUID, secretEncrypted, recoveryCodeHashes)
+	if err := uc.mfaRepo.Create(ctx, mfa); err != nil {
+		return nil, err
+	}
+
+	otpauthURL := fmt.Sprintf("otpauth://totp/AuthService:%s?secret=%s&issuer=AuthService", user.Email, secret)
+
+	var qrCodePNGBase64 string
+	if uc.qrEncoder != nil {
+		png, err := uc.qrEncoder.EncodePNG(otpauthURL, 256)
+		if err != nil {
+			return nil, err
+		}
+		qrCodePNGBase64 = base64.StdEncoding.EncodeToString(png)
+	}
+
+	return &dto.EnrollTOTPResponse{
+		Secret:        secret,
+		OTPAuthURL:    otpauthURL,
+		QRCodePNG:     qrCodePNGBase64,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// ConfirmTOTP activates a pending TOTP enrollment after verifying the user
+// can produce a valid code, proving they copied the secret correctly.
+func (uc *AuthUseCase) ConfirmTOTP(ctx context.Context, userID, code string) error {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+
+	mfa, err := uc.mfaRepo.FindByUserID(ctx, userUUID)
+	if err != nil {
+		return domainErr.ErrMFANotEnabled
+	}
+	if mfa.Enabled {
+		return domainErr.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := uc.encryptor.Decrypt(mfa.SecretEncrypted)
+	if err != nil {
+		return err
+	}
+
+	counter, ok := uc.totpService.Verify(secret, code, mfa.LastUsedCounter)
+	if !ok {
+		return domainErr.ErrInvalidMFACode
+	}
+
+	mfa.AcceptCounter(counter)
+	mfa.Confirm()
+	if err := uc.mfaRepo.Update(ctx, mfa); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionMFAEnabled, "", "")
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return nil
+}
+
+// DisableTOTP removes MFA enrollment after re-verifying the account
+// password, a step-up check since disabling MFA weakens the account.
+func (uc *AuthUseCase) DisableTOTP(ctx context.Context, userID, password, reauthToken string) error {
+	if err := uc.requireReauth(userID, reauthToken); err != nil {
+		return err
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return domainErr.ErrUserNotFound
+	}
+
+	if err := user.VerifyPassword(password, uc.passwordHasher); err != nil {
+		return domainErr.ErrInvalidPassword
+	}
+
+	if _, err := uc.mfaRepo.FindByUserID(ctx, userUUID); err != nil {
+		return domainErr.ErrMFANotEnabled
+	}
+
+	if err := uc.mfaRepo.Delete(ctx, userUUID); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionMFADisabled, "", "")
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return nil
+}
+
+// Reauthenticate confirms the caller still controls the account - fresh
+// password, plus a TOTP code if MFA is enabled - and mints a short-lived
+// token that ChangePassword and DisableTOTP require, the same step-up
+// pattern Supabase's reauthenticate endpoint uses.
+func (uc *AuthUseCase) Reauthenticate(ctx context.Context, userID string, req dto.ReauthenticateRequest) (*dto.ReauthenticateResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	if err := user.VerifyPassword(req.Password, uc.passwordHasher); err != nil {
+		return nil, domainErr.ErrInvalidPassword
+	}
+
+	if mfa, err := uc.mfaRepo.FindByUserID(ctx, userUUID); err == nil && mfa.Enabled {
+		secret, err := uc.encryptor.Decrypt(mfa.SecretEncrypted)
+		if err != nil {
+			return nil, err
+		}
+		counter, ok := uc.totpService.Verify(secret, req.Code, mfa.LastUsedCounter)
+		if !ok {
+			return nil, domainErr.ErrInvalidMFACode
+		}
+		mfa.AcceptCounter(counter)
+		if err := uc.mfaRepo.Update(ctx, mfa); err != nil {
+			return nil, err
+		}
+	}
+
+	reauthToken, err := uc.tokenService.GenerateSensitiveOpToken(userID, uc.config.SensitiveOpTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ReauthenticateResponse{
+		ReauthToken: reauthToken,
+		ExpiresIn:   int(uc.config.SensitiveOpTTL.Seconds()),
+	}, nil
+}
+
+// requireReauth rejects a sensitive operation unless token is a valid,
+// unexpired Reauthenticate token minted for this same user.
+func (uc *AuthUseCase) requireReauth(userID, token string) error {
+	if token == "" {
+		return domainErr.ErrReauthenticationRequired
+	}
+	subject, err := uc.tokenService.ValidateSensitiveOpToken(token)
+	if err != nil || subject != userID {
+		return domainErr.ErrReauthenticationRequired
+	}
+	return nil
+}
+
+// BeginWebAuthnRegistration starts a ceremony to register a new passkey for
+// an already-authenticated user, alongside any credentials they already
+// have.
+func (uc *AuthUseCase) BeginWebAuthnRegistration(ctx context.Context, userID string) (*dto.BeginWebAuthnCeremonyResponse, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	existing, err := uc.webauthnCredRepo.FindByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsJSON, sessionData, err := uc.webauthn.BeginRegistration(userUUID, user.Email, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := entity.NewWebAuthnChallenge(userUUID, entity.WebAuthnChallengeRegistration, sessionData, uc.config.WebAuthnChallengeTTL)
+	if err := uc.webauthnChallengeRepo.Create(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &dto.BeginWebAuthnCeremonyResponse{ChallengeID: challenge.ID.String(), Options: optionsJSON}, nil
+}
+
+// FinishWebAuthnRegistration verifies the browser's attestation response
+// against the challenge BeginWebAuthnRegistration issued and persists the
+// new credential.
+func (uc *AuthUseCase) FinishWebAuthnRegistration(ctx context.Context, userID string, req dto.FinishWebAuthnRequest) (*dto.WebAuthnCredentialDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	challenge, err := uc.loadWebAuthnChallenge(ctx, req.ChallengeID, entity.WebAuthnChallengeRegistration)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UserID != userUUID {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	existing, err := uc.webauthnCredRepo.FindByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialID, publicKey, attestationType, transports, aaguid, signCount, err := uc.webauthn.FinishRegistration(existing, userUUID, user.Email, challenge.SessionData, req.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := entity.NewWebAuthnCredential(userUUID, credentialID, publicKey, attestationType, transports, aaguid, signCount)
+	if err := uc.webauthnCredRepo.Create(ctx, credential); err != nil {
+		return nil, err
+	}
+	_ = uc.webauthnChallengeRepo.MarkConsumed(ctx, challenge.ID)
+
+	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionWebAuthnRegistered, "", "")
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return &dto.WebAuthnCredentialDTO{
+		ID:              credential.ID.String(),
+		AttestationType: credential.AttestationType,
+		Transports:      credential.Transports,
+		CreatedAt:       credential.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// BeginWebAuthnLogin starts the WebAuthn ceremony completing a login whose
+// password step already succeeded, identified by ticket (see
+// GenerateLoginCompletionTicket). It is not a standalone, password-less
+// entry point: without a valid, unexpired, mfa_pending ticket naming a real
+// user there's nothing to begin.
+func (uc *AuthUseCase) BeginWebAuthnLogin(ctx context.Context, ticket string) (*dto.BeginWebAuthnCeremonyResponse, error) {
+	userID, err := uc.tokenService.ValidateLoginCompletionTicket(ticket)
+	if err != nil {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, userUUID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	existing, err := uc.webauthnCredRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, domainErr.ErrNoWebAuthnCredentials
+	}
+
+	optionsJSON, sessionData, err := uc.webauthn.BeginLogin(user.ID, user.Email, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := entity.NewWebAuthnChallenge(user.ID, entity.WebAuthnChallengeLogin, sessionData, uc.config.WebAuthnChallengeTTL)
+	if err := uc.webauthnChallengeRepo.Create(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &dto.BeginWebAuthnCeremonyResponse{ChallengeID: challenge.ID.String(), Options: optionsJSON}, nil
+}
+
+// FinishWebAuthnLogin verifies the browser's assertion response, re-checks
+// that req.Ticket still names the same user the matching Begin call did
+// (so the ceremony can't be redirected onto a different account), updates
+// the credential's sign count to detect a cloned authenticator on a later
+// login, and completes the login by issuing a real token pair.
+func (uc *AuthUseCase) FinishWebAuthnLogin(ctx context.Context, req dto.FinishWebAuthnLoginRequest, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	ticketUserID, err := uc.tokenService.ValidateLoginCompletionTicket(req.Ticket)
+	if err != nil {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	challenge, err := uc.loadWebAuthnChallenge(ctx, req.ChallengeID, entity.WebAuthnChallengeLogin)
+	if err != nil {
 		return nil, err
 	}
+	if challenge.UserID.String() != ticketUserID {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, domainErr.ErrUserNotFound
+	}
+
+	existing, err := uc.webauthnCredRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialID, newSignCount, err := uc.webauthn.FinishLogin(existing, user.ID, user.Email, challenge.SessionData, req.Response)
+	if err != nil {
+		auditLog := entity.NewAuditLog(user.ID, entity.AuditActionMFAChallengeFailed, ipAddress, userAgent)
+		uc.auditRecorder.Record(ctx, auditLog)
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	for _, cred := range existing {
+		if bytes.Equal(cred.CredentialID, credentialID) {
+			cred.UpdateSignCount(newSignCount)
+			if err := uc.webauthnCredRepo.UpdateSignCount(ctx, cred.ID, newSignCount); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	_ = uc.webauthnChallengeRepo.MarkConsumed(ctx, challenge.ID)
 
 	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionLogin, ipAddress, userAgent)
-	_ = uc.auditRepo.Create(ctx, auditLog)
+	uc.auditRecorder.Record(ctx, auditLog)
+
+	return uc.issueTokenPair(ctx, user, ipAddress, userAgent)
+}
+
+// loadWebAuthnChallenge fetches and validates a challenge issued by a
+// Begin* call, rejecting mismatched purposes so a registration challenge
+// can't be replayed to complete a login (or vice versa).
+func (uc *AuthUseCase) loadWebAuthnChallenge(ctx context.Context, challengeID string, purpose entity.WebAuthnChallengePurpose) (*entity.WebAuthnChallenge, error) {
+	challengeUUID, err := uuid.Parse(challengeID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	challenge, err := uc.webauthnChallengeRepo.FindByID(ctx, challengeUUID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+	if challenge.Purpose != purpose || !challenge.IsValid() {
+		return nil, domainErr.ErrInvalidWebAuthnChallenge
+	}
+
+	return challenge, nil
+}
+
+func (uc *AuthUseCase) issueTokenPair(ctx context.Context, user *entity.User, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	roles, permissions, err := uc.effectivePermissions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := uc.tokenService.GenerateAccessTokenWithClaims(user.ID.String(), roles, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshPlain, refreshHash, err := uc.tokenService.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := uc.deviceFingerprint(ipAddress, userAgent)
+	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, fingerprint, uc.config.RefreshTokenTTL)
+	if err := uc.refreshRepo.Create(ctx, refreshToken); err != nil {
+		return nil, err
+	}
 
 	return &dto.LoginResponse{
 		AccessToken:  accessToken,
@@ -149,7 +902,65 @@ func (uc *AuthUseCase) Login(ctx context.Context, req dto.LoginRequest, ipAddres
 	}, nil
 }
 
-func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain string) (*dto.RefreshTokenResponse, error) {
+// effectivePermissions returns a user's assigned role names together with
+// the deduplicated union of every permission those roles (and their
+// ancestors) grant, for baking into the access token as claims.
+func (uc *AuthUseCase) effectivePermissions(ctx context.Context, userID uuid.UUID) (roles, permissions []string, err error) {
+	roles, err = uc.roleRepo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seen := make(map[string]struct{})
+	for _, roleName := range roles {
+		for _, perm := range uc.resolveRolePermissions(ctx, roleName) {
+			seen[perm] = struct{}{}
+		}
+	}
+
+	permissions = make([]string, 0, len(seen))
+	for perm := range seen {
+		permissions = append(permissions, perm)
+	}
+	return roles, permissions, nil
+}
+
+// resolveRolePermissions walks a role's Parent chain, collecting every
+// inherited permission. Errors (e.g. a dangling parent name) are treated as
+// "no further permissions" rather than failing the whole login.
+func (uc *AuthUseCase) resolveRolePermissions(ctx context.Context, roleName string) []string {
+	var permissions []string
+	visited := make(map[string]bool)
+
+	for roleName != "" && !visited[roleName] {
+		visited[roleName] = true
+
+		role, err := uc.roleRepo.FindByName(ctx, roleName)
+		if err != nil {
+			break
+		}
+		permissions = append(permissions, role.Permissions...)
+		roleName = role.Parent
+	}
+
+	return permissions
+}
+
+func generateRecoveryCode() (plain, hash string, err error) {
+	raw := make([]byte, 5)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	plain = strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw))
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return "", "", err
+	}
+	return plain, string(hashed), nil
+}
+
+func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain, ipAddress, userAgent string) (*dto.RefreshTokenResponse, error) {
 	if refreshPlain == "" {
 		return nil, domainErr.ErrMissingToken
 	}
@@ -161,15 +972,44 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain string) (*
 		return nil, domainErr.ErrInvalidToken
 	}
 
-	if !token.IsValid() {
+	if token.IsRevoked {
+		// Only a token that was revoked by being rotated away is reuse -
+		// a token revoked by logout or an admin action being presented
+		// again is just stale, not evidence the family is compromised.
+		if token.WasReplaced() {
+			_ = uc.refreshRepo.RevokeFamily(ctx, token.FamilyID)
+
+			auditLog := entity.NewAuditLog(token.UserID, entity.AuditActionTokenReuseDetected, ipAddress, userAgent)
+			uc.auditRecorder.Record(ctx, auditLog)
+			logger.FromContext(ctx).Warn("refresh token reuse detected, revoking session family",
+				zap.String("user_id", token.UserID.String()),
+				zap.String("family_id", token.FamilyID.String()),
+			)
+		}
+
+		return nil, domainErr.ErrTokenRevoked
+	}
+
+	if token.IsExpired() {
 		return nil, domainErr.ErrTokenExpired
 	}
 
-	if err := uc.refreshRepo.RevokeByTokenHash(ctx, refreshHash); err != nil {
+	fingerprint := uc.deviceFingerprint(ipAddress, userAgent)
+	if token.FingerprintHash != "" && token.FingerprintHash != fingerprint {
+		auditLog := entity.NewAuditLog(token.UserID, entity.AuditActionTokenFingerprintMismatch, ipAddress, userAgent)
+		uc.auditRecorder.Record(ctx, auditLog)
+		logger.FromContext(ctx).Warn("refresh token used from an unrecognized device fingerprint",
+			zap.String("user_id", token.UserID.String()),
+			zap.String("family_id", token.FamilyID.String()),
+		)
+	}
+
+	roles, permissions, err := uc.effectivePermissions(ctx, token.UserID)
+	if err != nil {
 		return nil, err
 	}
 
-	newAccessToken, err := uc.tokenService.GenerateAccessToken(token.UserID.String())
+	newAccessToken, err := uc.tokenService.GenerateAccessTokenWithClaims(token.UserID.String(), roles, permissions)
 	if err != nil {
 		return nil, err
 	}
@@ -179,13 +1019,16 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain string) (*
 		return nil, err
 	}
 
-	newRefreshToken := entity.NewRefreshToken(token.UserID, newRefreshHash, uc.config.RefreshTokenTTL)
+	newRefreshToken := entity.NewRotatedRefreshToken(token.UserID, token.FamilyID, newRefreshHash, fingerprint, uc.config.RefreshTokenTTL)
 	if err := uc.refreshRepo.Create(ctx, newRefreshToken); err != nil {
 		return nil, err
 	}
+	if err := uc.refreshRepo.RevokeAndReplace(ctx, refreshHash, newRefreshToken.ID); err != nil {
+		return nil, err
+	}
 
-	auditLog := entity.NewAuditLog(token.UserID, entity.AuditActionTokenRefresh, "", "")
-	_ = uc.auditRepo.Create(ctx, auditLog)
+	auditLog := entity.NewAuditLog(token.UserID, entity.AuditActionTokenRefresh, ipAddress, userAgent)
+	uc.auditRecorder.Record(ctx, auditLog)
 
 	return &dto.RefreshTokenResponse{
 		AccessToken:  newAccessToken,
@@ -195,6 +1038,29 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, refreshPlain string) (*
 	}, nil
 }
 
+// deviceFingerprint derives a stable per-device identifier from the
+// issuing request's User-Agent and the /24 prefix of its IP address (the
+// full address is deliberately not used, since it can legitimately change
+// mid-session behind mobile networks or NAT), hashed so the raw UA/IP
+// can't be recovered from storage.
+func (uc *AuthUseCase) deviceFingerprint(ipAddress, userAgent string) string {
+	return uc.tokenService.HashToken(userAgent + "|" + ipPrefix24(ipAddress))
+}
+
+// ipPrefix24 returns the /24 network prefix of an IPv4 address ("a.b.c.0"),
+// or ip unchanged if it isn't a parseable IPv4 address.
+func ipPrefix24(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	v4 := parsed.To4()
+	if v4 == nil {
+		return ip
+	}
+	return fmt.Sprintf("%d.%d.%d.0", v4[0], v4[1], v4[2])
+}
+
 func (uc *AuthUseCase) Logout(ctx context.Context, userID string, refreshPlain string, ipAddress, userAgent string) error {
 	if refreshPlain != "" {
 		refreshHash := uc.tokenService.HashToken(refreshPlain)
@@ -205,7 +1071,7 @@ func (uc *AuthUseCase) Logout(ctx context.Context, userID string, refreshPlain s
 		userUUID, err := uuid.Parse(userID)
 		if err == nil {
 			auditLog := entity.NewAuditLog(userUUID, entity.AuditActionLogout, ipAddress, userAgent)
-			_ = uc.auditRepo.Create(ctx, auditLog)
+			uc.auditRecorder.Record(ctx, auditLog)
 		}
 	}
 
@@ -224,7 +1090,7 @@ func (uc *AuthUseCase) LogoutAll(ctx context.Context, userID string, ipAddress,
 
 	auditLog := entity.NewAuditLog(userUUID, entity.AuditActionLogout, ipAddress, userAgent)
 	auditLog.AddMetadata("all_sessions", true)
-	_ = uc.auditRepo.Create(ctx, auditLog)
+	uc.auditRecorder.Record(ctx, auditLog)
 
 	return nil
 }
@@ -255,12 +1121,20 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID string, req dt
 		return domainErr.ErrInvalidInput
 	}
 
+	if err := uc.requireReauth(userID, req.ReauthToken); err != nil {
+		return err
+	}
+
 	user, err := uc.userRepo.FindByID(ctx, userUUID)
 	if err != nil {
 		return domainErr.ErrUserNotFound
 	}
 
-	if err := user.VerifyPassword(req.OldPassword); err != nil {
+	if !user.HasPassword() {
+		return domainErr.ErrNoPasswordSet
+	}
+
+	if err := user.VerifyPassword(req.OldPassword, uc.passwordHasher); err != nil {
 		return domainErr.ErrInvalidPassword
 	}
 
@@ -268,7 +1142,7 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID string, req dt
 		return err
 	}
 
-	if err := user.ChangePassword(req.NewPassword); err != nil {
+	if err := user.ChangePassword(req.NewPassword, uc.passwordHasher); err != nil {
 		return err
 	}
 
@@ -279,7 +1153,7 @@ func (uc *AuthUseCase) ChangePassword(ctx context.Context, userID string, req dt
 	_ = uc.refreshRepo.RevokeAllByUserID(ctx, userUUID)
 
 	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionPasswordChange, "", "")
-	_ = uc.auditRepo.Create(ctx, auditLog)
+	uc.auditRecorder.Record(ctx, auditLog)
 
 	return nil
 }
@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// LoginProvider is implemented by every external identity backend
+// (Google, GitHub, generic OIDC) this service can federate login through.
+// Exchange performs the full authorization_code exchange plus whatever
+// identity verification the provider supports (id_token signature + nonce
+// for OIDC-compliant providers), returning emailVerified as the provider's
+// own assertion so callers never have to trust an unverified email.
+type LoginProvider interface {
+	Name() string
+	AuthURL(state, nonce, codeChallenge string) string
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (subject, email string, emailVerified bool, err error)
+}
+
+// StateSigner prevents CSRF on the provider callback by signing/verifying
+// the `state` query parameter round-tripped through the IdP. The signed
+// payload carries the PKCE code verifier so it survives the round trip
+// without server-side storage.
+type StateSigner interface {
+	Sign(payload string) (string, error)
+	Verify(state string) (payload string, err error)
+}
+
+// IdentityUseCase implements social login and account linking against one
+// or more LoginProviders, keyed by provider name.
+type IdentityUseCase struct {
+	userRepo     repository.UserRepository
+	identityRepo repository.IdentityRepository
+	refreshRepo  repository.RefreshTokenRepository
+	auditRepo    repository.AuditLogRepository
+	roleRepo     repository.RoleRepository
+	tokens       TokenService
+	state        StateSigner
+	providers    map[string]LoginProvider
+	config       AuthConfig
+}
+
+func NewIdentityUseCase(
+	userRepo repository.UserRepository,
+	identityRepo repository.IdentityRepository,
+	refreshRepo repository.RefreshTokenRepository,
+	auditRepo repository.AuditLogRepository,
+	roleRepo repository.RoleRepository,
+	tokens TokenService,
+	state StateSigner,
+	providers map[string]LoginProvider,
+	config AuthConfig,
+) *IdentityUseCase {
+	return &IdentityUseCase{
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+		refreshRepo:  refreshRepo,
+		auditRepo:    auditRepo,
+		roleRepo:     roleRepo,
+		tokens:       tokens,
+		state:        state,
+		providers:    providers,
+		config:       config,
+	}
+}
+
+// AuthURL starts the PKCE dance: it generates a code verifier and a nonce,
+// signs both into the `state` parameter so they survive the round trip
+// through the IdP without server-side storage, and returns the provider's
+// authorization URL carrying the derived S256 code challenge. The nonce is
+// echoed back inside the provider's id_token and checked in Callback, so a
+// stolen authorization code can't be replayed as a fresh login.
+func (uc *IdentityUseCase) AuthURL(providerName string) (string, error) {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return "", domainErr.ErrProviderNotSupported
+	}
+
+	codeVerifier, err := generateCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := generateCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	state, err := uc.state.Sign(codeVerifier + "|" + nonce)
+	if err != nil {
+		return "", err
+	}
+
+	return provider.AuthURL(state, nonce, codeChallengeS256(codeVerifier)), nil
+}
+
+// Callback exchanges the authorization code for the external provider's
+// verified profile, and either links it to an existing user (matched by
+// provider+subject) or creates a new account. Creating or auto-linking by
+// email requires the provider to assert the email is verified — otherwise
+// an attacker could register an external account under a victim's
+// unverified email and get folded into the victim's local account.
+func (uc *IdentityUseCase) Callback(ctx context.Context, providerName, code, state, ipAddress, userAgent string) (*dto.LoginResponse, error) {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return nil, domainErr.ErrProviderNotSupported
+	}
+
+	payload, err := uc.state.Verify(state)
+	if err != nil {
+		return nil, domainErr.ErrInvalidState
+	}
+	codeVerifier, nonce, ok := strings.Cut(payload, "|")
+	if !ok {
+		return nil, domainErr.ErrInvalidState
+	}
+
+	subject, email, emailVerified, err := provider.Exchange(ctx, code, codeVerifier, nonce)
+	if err != nil {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	identity, err := uc.identityRepo.FindByProviderSubject(ctx, providerName, subject)
+	var user *entity.User
+	if err == nil {
+		user, err = uc.userRepo.FindByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !emailVerified {
+			return nil, domainErr.ErrProviderEmailUnverified
+		}
+		user, err = uc.findOrCreateUserByEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.identityRepo.Create(ctx, entity.NewIdentity(user.ID, providerName, subject, email)); err != nil {
+			return nil, err
+		}
+	}
+
+	auditLog := entity.NewAuditLog(user.ID, entity.AuditActionProviderLogin, ipAddress, userAgent)
+	auditLog.AddMetadata("provider", providerName)
+	_ = uc.auditRepo.Create(ctx, auditLog)
+
+	return uc.issueTokenPair(ctx, user)
+}
+
+// LinkIdentity attaches an additional external provider to an already
+// authenticated user. codeVerifier is the PKCE verifier the client generated
+// for its own authorization request against the provider; since that
+// request never went through AuthURL there is no signed nonce to check
+// against, so Exchange is called with an empty one (the caller is already
+// authenticated, so there's no account to take over by email).
+func (uc *IdentityUseCase) LinkIdentity(ctx context.Context, userID, providerName, code, codeVerifier string) error {
+	provider, ok := uc.providers[providerName]
+	if !ok {
+		return domainErr.ErrProviderNotSupported
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+
+	subject, email, _, err := provider.Exchange(ctx, code, codeVerifier, "")
+	if err != nil {
+		return domainErr.ErrInvalidGrant
+	}
+
+	if _, err := uc.identityRepo.FindByProviderSubject(ctx, providerName, subject); err == nil {
+		return domainErr.ErrIdentityAlreadyLinked
+	}
+
+	if err := uc.identityRepo.Create(ctx, entity.NewIdentity(id, providerName, subject, email)); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(id, entity.AuditActionIdentityLinked, "", "")
+	auditLog.AddMetadata("provider", providerName)
+	_ = uc.auditRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// UnlinkIdentity removes a linked provider, refusing to remove the last
+// remaining sign-in method for the account.
+func (uc *IdentityUseCase) UnlinkIdentity(ctx context.Context, userID, providerName string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return domainErr.ErrInvalidInput
+	}
+
+	count, err := uc.identityRepo.CountByUserID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// A password-holding user can always drop down to one remaining
+	// identity; a password-less (provider-only) user needs at least two.
+	if user.PasswordHash == "" && count <= 1 {
+		return domainErr.ErrLastCredential
+	}
+
+	if err := uc.identityRepo.DeleteByUserIDAndProvider(ctx, id, providerName); err != nil {
+		return err
+	}
+
+	auditLog := entity.NewAuditLog(id, entity.AuditActionIdentityUnlinked, "", "")
+	auditLog.AddMetadata("provider", providerName)
+	_ = uc.auditRepo.Create(ctx, auditLog)
+
+	return nil
+}
+
+// generateCodeVerifier returns a random RFC 7636 PKCE code verifier.
+func generateCodeVerifier() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// codeChallengeS256 derives the S256 PKCE code challenge for a verifier.
+func codeChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (uc *IdentityUseCase) findOrCreateUserByEmail(ctx context.Context, email string) (*entity.User, error) {
+	user, err := uc.userRepo.FindByEmail(ctx, email)
+	if err == nil {
+		return user, nil
+	}
+
+	newUser := &entity.User{
+		ID:         uuid.Must(uuid.NewV7()),
+		Email:      email,
+		IsVerified: true,
+		Role:       entity.RoleUser,
+	}
+	if err := uc.userRepo.Create(ctx, newUser); err != nil {
+		return nil, err
+	}
+	if err := uc.roleRepo.AssignToUser(ctx, newUser.ID, entity.DefaultRoleUser); err != nil {
+		return nil, err
+	}
+	return newUser, nil
+}
+
+func (uc *IdentityUseCase) issueTokenPair(ctx context.Context, user *entity.User) (*dto.LoginResponse, error) {
+	roleNames, permissions, err := uc.effectivePermissions(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := uc.tokens.GenerateAccessTokenWithClaims(user.ID.String(), roleNames, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshPlain, refreshHash, err := uc.tokens.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, "", uc.config.RefreshTokenTTL)
+	if err := uc.refreshRepo.Create(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &dto.LoginResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshPlain,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(uc.config.AccessTokenTTL.Seconds()),
+		User: dto.UserDTO{
+			ID:         user.ID.String(),
+			Email:      user.Email,
+			Role:       string(user.Role),
+			IsVerified: user.IsVerified,
+			CreatedAt:  user.CreatedAt,
+		},
+	}, nil
+}
+
+// effectivePermissions returns a user's assigned role names together with
+// the deduplicated union of every permission those roles (and their
+// ancestors) grant, for baking into the access token as claims.
+func (uc *IdentityUseCase) effectivePermissions(ctx context.Context, userID uuid.UUID) (roles, permissions []string, err error) {
+	roles, err = uc.roleRepo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seen := make(map[string]struct{})
+	for _, roleName := range roles {
+		for _, perm := range uc.resolveRolePermissions(ctx, roleName) {
+			seen[perm] = struct{}{}
+		}
+	}
+
+	permissions = make([]string, 0, len(seen))
+	for perm := range seen {
+		permissions = append(permissions, perm)
+	}
+	return roles, permissions, nil
+}
+
+// resolveRolePermissions walks a role's Parent chain, collecting every
+// inherited permission.
+func (uc *IdentityUseCase) resolveRolePermissions(ctx context.Context, roleName string) []string {
+	var permissions []string
+	visited := make(map[string]bool)
+
+	for roleName != "" && !visited[roleName] {
+		visited[roleName] = true
+
+		role, err := uc.roleRepo.FindByName(ctx, roleName)
+		if err != nil {
+			break
+		}
+		permissions = append(permissions, role.Permissions...)
+		roleName = role.Parent
+	}
+
+	return permissions
+}
@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/domain/repository"
+	domainSecurity "auth-service/internal/domain/security"
+
+	"github.com/google/uuid"
+)
+
+// IDTokenSigner signs OIDC id_tokens and publishes the corresponding JWKS.
+type IDTokenSigner interface {
+	SignIDToken(subject, audience, nonce string, ttl time.Duration) (string, error)
+}
+
+type OAuthConfig struct {
+	AuthCodeTTL     time.Duration
+	IDTokenTTL      time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// OAuthUseCase implements the authorization-code + PKCE flow that turns
+// this service into an OAuth2/OIDC identity provider for third-party
+// clients, alongside the first-party flows in AuthUseCase.
+type OAuthUseCase struct {
+	clientRepo     repository.ClientRepository
+	codeRepo       repository.AuthorizationCodeRepository
+	refreshRepo    repository.RefreshTokenRepository
+	userRepo       repository.UserRepository
+	tokens         TokenService
+	idSigner       IDTokenSigner
+	passwordHasher domainSecurity.PasswordHasher
+	config         OAuthConfig
+}
+
+func NewOAuthUseCase(
+	clientRepo repository.ClientRepository,
+	codeRepo repository.AuthorizationCodeRepository,
+	refreshRepo repository.RefreshTokenRepository,
+	userRepo repository.UserRepository,
+	tokens TokenService,
+	idSigner IDTokenSigner,
+	passwordHasher domainSecurity.PasswordHasher,
+	config OAuthConfig,
+) *OAuthUseCase {
+	return &OAuthUseCase{
+		clientRepo:     clientRepo,
+		codeRepo:       codeRepo,
+		refreshRepo:    refreshRepo,
+		userRepo:       userRepo,
+		tokens:         tokens,
+		idSigner:       idSigner,
+		passwordHasher: passwordHasher,
+		config:         config,
+	}
+}
+
+// authenticateClient verifies a confidential client's secret against its
+// stored hash (RFC 6749 §2.3); public clients have no secret to check, so
+// PKCE alone stands in for client authentication on those.
+func (uc *OAuthUseCase) authenticateClient(client *entity.Client, clientSecret string) error {
+	if !client.IsConfidential {
+		return nil
+	}
+	if clientSecret == "" {
+		return domainErr.ErrInvalidClient
+	}
+	valid, err := uc.passwordHasher.Verify(client.ClientSecretHash, clientSecret)
+	if err != nil || !valid {
+		return domainErr.ErrInvalidClient
+	}
+	return nil
+}
+
+func (uc *OAuthUseCase) Authorize(ctx context.Context, req dto.AuthorizeRequest, userID uuid.UUID) (*dto.AuthorizeResponse, error) {
+	if req.ResponseType != "code" {
+		return nil, domainErr.ErrUnsupportedGrantType
+	}
+	// PKCE is mandatory and S256 is the only accepted challenge method -
+	// "plain" offers no protection against an intercepted authorization
+	// code, so it isn't worth supporting.
+	if req.CodeChallengeMethod != "S256" {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	client, err := uc.clientRepo.FindByClientID(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if !client.AllowsRedirectURI(req.RedirectURI) {
+		return nil, domainErr.ErrInvalidRedirectURI
+	}
+	if !client.AllowsGrantType("authorization_code") {
+		return nil, domainErr.ErrUnsupportedGrantType
+	}
+
+	scopes := client.AllowedScopeSet(strings.Fields(req.Scope))
+
+	codePlain, codeHash, err := uc.tokens.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	code := entity.NewAuthorizationCode(
+		codeHash,
+		client.ClientID,
+		userID,
+		req.RedirectURI,
+		scopes,
+		req.CodeChallenge,
+		req.CodeChallengeMethod,
+		req.Nonce,
+		uc.config.AuthCodeTTL,
+	)
+	if err := uc.codeRepo.Create(ctx, code); err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthorizeResponse{
+		RedirectURI: req.RedirectURI,
+		Code:        codePlain,
+		State:       req.State,
+	}, nil
+}
+
+func (uc *OAuthUseCase) Token(ctx context.Context, req dto.TokenRequest) (*dto.TokenResponse, error) {
+	switch req.GrantType {
+	case "authorization_code":
+		return uc.tokenFromAuthorizationCode(ctx, req)
+	case "refresh_token":
+		return uc.tokenFromRefreshToken(ctx, req)
+	default:
+		return nil, domainErr.ErrUnsupportedGrantType
+	}
+}
+
+func (uc *OAuthUseCase) tokenFromAuthorizationCode(ctx context.Context, req dto.TokenRequest) (*dto.TokenResponse, error) {
+	if req.Code == "" || req.CodeVerifier == "" {
+		return nil, domainErr.ErrInvalidGrant
+	}
+
+	client, err := uc.clientRepo.FindByClientID(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authenticateClient(client, req.ClientSecret); err != nil {
+		return nil, err
+	}
+
+	codeHash := uc.tokens.HashToken(req.Code)
+	authCode, err := uc.codeRepo.FindByCodeHash(ctx, codeHash)
+	if err != nil {
+		return nil, err
+	}
+	if !authCode.IsValid() || authCode.ClientID != client.ClientID || authCode.RedirectURI != req.RedirectURI {
+		return nil, domainErr.ErrInvalidGrant
+	}
+	if err := verifyPKCE(authCode.CodeChallenge, authCode.CodeChallengeMethod, req.CodeVerifier); err != nil {
+		return nil, err
+	}
+
+	if err := uc.codeRepo.MarkUsed(ctx, authCode.ID.String()); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, authCode.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := uc.tokens.GenerateAccessToken(user.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	idToken, err := uc.idSigner.SignIDToken(user.ID.String(), client.ClientID, authCode.Nonce, uc.config.IDTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshPlain, refreshHash, err := uc.tokens.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken := entity.NewRefreshToken(user.ID, refreshHash, "", uc.config.RefreshTokenTTL)
+	if err := uc.refreshRepo.Create(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &dto.TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(uc.config.IDTokenTTL.Seconds()),
+		IDToken:      idToken,
+		RefreshToken: refreshPlain,
+		Scope:        strings.Join(authCode.Scopes, " "),
+	}, nil
+}
+
+// tokenFromRefreshToken implements the refresh_token grant on top of the
+// same RefreshTokenRepository the first-party cookie flow uses, including
+// rotation and reuse detection: presenting a token that's already been
+// rotated away revokes its whole family, since that can only happen if the
+// token leaked.
+func (uc *OAuthUseCase) tokenFromRefreshToken(ctx context.Context, req dto.TokenRequest) (*dto.TokenResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, domainErr.ErrInvalidGrant
+	}
+	client, err := uc.clientRepo.FindByClientID(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authenticateClient(client, req.ClientSecret); err != nil {
+		return nil, err
+	}
+
+	refreshHash := uc.tokens.HashToken(req.RefreshToken)
+	token, err := uc.refreshRepo.FindByTokenHash(ctx, refreshHash)
+	if err != nil {
+		return nil, domainErr.ErrInvalidToken
+	}
+
+	if token.IsRevoked {
+		if token.WasReplaced() {
+			_ = uc.refreshRepo.RevokeFamily(ctx, token.FamilyID)
+		}
+		return nil, domainErr.ErrTokenRevoked
+	}
+	if token.IsExpired() {
+		return nil, domainErr.ErrTokenExpired
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := uc.tokens.GenerateAccessToken(user.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	newRefreshPlain, newRefreshHash, err := uc.tokens.GenerateRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	newRefreshToken := entity.NewRotatedRefreshToken(user.ID, token.FamilyID, newRefreshHash, "", uc.config.RefreshTokenTTL)
+	if err := uc.refreshRepo.Create(ctx, newRefreshToken); err != nil {
+		return nil, err
+	}
+	if err := uc.refreshRepo.RevokeAndReplace(ctx, refreshHash, newRefreshToken.ID); err != nil {
+		return nil, err
+	}
+
+	return &dto.TokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(uc.config.IDTokenTTL.Seconds()),
+		RefreshToken: newRefreshPlain,
+	}, nil
+}
+
+func (uc *OAuthUseCase) UserInfo(ctx context.Context, userID string) (*dto.UserInfoResponse, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	user, err := uc.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.UserInfoResponse{
+		Subject: user.ID.String(),
+		Email:   user.Email,
+	}, nil
+}
+
+// Introspect implements RFC 7662: the caller must authenticate as a
+// registered client before learning anything about the token, so a bare
+// bearer token can't be fed back in by a party that never had it.
+func (uc *OAuthUseCase) Introspect(ctx context.Context, accessToken, clientID, clientSecret string) (*dto.IntrospectResponse, error) {
+	client, err := uc.clientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		return nil, domainErr.ErrInvalidClient
+	}
+	if err := uc.authenticateClient(client, clientSecret); err != nil {
+		return nil, err
+	}
+
+	principal, err := uc.tokens.ValidateAccessToken(accessToken)
+	if err != nil {
+		return &dto.IntrospectResponse{Active: false}, nil
+	}
+
+	return &dto.IntrospectResponse{
+		Active:  true,
+		Subject: principal.UserID,
+	}, nil
+}
+
+func verifyPKCE(challenge, method, verifier string) error {
+	if method != "S256" {
+		return domainErr.ErrInvalidGrant
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(challenge), []byte(computed)) != 1 {
+		return domainErr.ErrInvalidCodeVerifier
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package controller
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -129,8 +130,9 @@ func Logout(authService *service.AuthService, cfg *config.Config) gin.HandlerFun
 	return func(ctx *gin.Context) {
 		// Logout phiên hiện tại theo refresh cookie
 		refreshPlain, _ := ctx.Cookie(cfg.RefreshCookieName)
+		jti, tokenExpiresAt := tokenClaimsFromContext(ctx)
 		if refreshPlain != "" {
-			_ = authService.LogoutCurrent(refreshPlain)
+			_ = authService.LogoutCurrent(refreshPlain, jti, tokenExpiresAt)
 		}
 		// Xoá cookie
 		clearCookie(ctx, cfg)
@@ -145,7 +147,8 @@ func LogoutAll(authService *service.AuthService, cfg *config.Config) gin.Handler
 			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
 			return
 		}
-		if err := authService.Logout(userID); err != nil {
+		jti, tokenExpiresAt := tokenClaimsFromContext(ctx)
+		if err := authService.Logout(userID, jti, tokenExpiresAt); err != nil {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
@@ -154,6 +157,15 @@ func LogoutAll(authService *service.AuthService, cfg *config.Config) gin.Handler
 	}
 }
 
+// tokenClaimsFromContext reads the jti and expiry AuthMiddleware set for the
+// access token the current request carried, so Logout/LogoutAll can
+// denylist it. Both are zero values if the route isn't behind AuthMiddleware.
+func tokenClaimsFromContext(ctx *gin.Context) (string, time.Time) {
+	jti := ctx.GetString("jti")
+	expiresAt, _ := ctx.Value("tokenExpiresAt").(time.Time)
+	return jti, expiresAt
+}
+
 // Helpers =================================================
 
 func setSameSite(c *gin.Context, mode string) {
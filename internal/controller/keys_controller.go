@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"auth-service/utils"
+)
+
+// JWKS serves the public signing keys in JWK format so downstream services
+// can verify access tokens without sharing the signing secret.
+func JWKS(keys *utils.KeyManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"keys": keys.JWKS()})
+	}
+}
+
+// RotateSigningKey forces a key rotation, retiring the current key into its
+// grace period and issuing a new one for subsequent tokens.
+func RotateSigningKey(keys *utils.KeyManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := keys.RotateKey(); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"auth-service/internal/service"
+)
+
+// Introspect serves POST /oauth/introspect (RFC 7662), callable only by an
+// authenticated client (see middleware.RequireClientAuth).
+func Introspect(authService *service.AuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req struct {
+			Token         string `form:"token" json:"token" binding:"required"`
+			TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"`
+		}
+		if err := ctx.ShouldBind(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			return
+		}
+
+		result := authService.Introspect(req.Token, req.TokenTypeHint)
+		if !result.Active {
+			ctx.JSON(http.StatusOK, gin.H{"active": false})
+			return
+		}
+
+		ctx.JSON(http.StatusOK, gin.H{
+			"active":     true,
+			"sub":        result.Subject,
+			"iat":        result.IssuedAt,
+			"exp":        result.ExpiresAt,
+			"token_type": result.TokenType,
+			"scope":      result.Scope,
+		})
+	}
+}
+
+// Revoke serves POST /oauth/revoke (RFC 7009). Per spec it returns 200
+// whether the token was valid, already revoked, or unknown to this service.
+func Revoke(authService *service.AuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var req struct {
+			Token         string `form:"token" json:"token" binding:"required"`
+			TokenTypeHint string `form:"token_type_hint" json:"token_type_hint"`
+		}
+		if err := ctx.ShouldBind(&req); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			return
+		}
+
+		authService.Revoke(req.Token, req.TokenTypeHint)
+		ctx.Status(http.StatusOK)
+	}
+}
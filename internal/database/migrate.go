@@ -12,6 +12,7 @@ func Migrate(db *gorm.DB) {
 	if err := db.AutoMigrate(
 		&model.User{},
 		&model.RefreshToken{},
+		&model.OAuthClient{},
 	); err != nil {
 		log.Fatalf("failed to migrate database: %v", err)
 	}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,15 +22,27 @@ type AuditLog struct {
 type AuditAction string
 
 const (
-	AuditActionLogin             AuditAction = "login"
-	AuditActionLoginFailed       AuditAction = "login_failed"
-	AuditActionLogout            AuditAction = "logout"
-	AuditActionRegister          AuditAction = "register"
-	AuditActionPasswordChange    AuditAction = "password_change"
-	AuditActionPasswordReset     AuditAction = "password_reset"
-	AuditActionEmailVerification AuditAction = "email_verification"
-	AuditActionTokenRefresh      AuditAction = "token_refresh"
-	AuditActionAccountLocked     AuditAction = "account_locked"
+	AuditActionLogin                    AuditAction = "login"
+	AuditActionLoginFailed              AuditAction = "login_failed"
+	AuditActionLogout                   AuditAction = "logout"
+	AuditActionRegister                 AuditAction = "register"
+	AuditActionPasswordChange           AuditAction = "password_change"
+	AuditActionPasswordReset            AuditAction = "password_reset"
+	AuditActionEmailVerification        AuditAction = "email_verification"
+	AuditActionTokenRefresh             AuditAction = "token_refresh"
+	AuditActionAccountLocked            AuditAction = "account_locked"
+	AuditActionIdentityLinked           AuditAction = "identity_linked"
+	AuditActionIdentityUnlinked         AuditAction = "identity_unlinked"
+	AuditActionProviderLogin            AuditAction = "provider_login"
+	AuditActionMFAEnabled               AuditAction = "mfa_enabled"
+	AuditActionMFADisabled              AuditAction = "mfa_disabled"
+	AuditActionMFAChallengeFailed       AuditAction = "mfa_challenge_failed"
+	AuditActionTokenReuseDetected       AuditAction = "token_reuse_detected"
+	AuditActionTokenFingerprintMismatch AuditAction = "token_fingerprint_mismatch"
+	AuditActionWebAuthnRegistered       AuditAction = "webauthn_registered"
+	AuditActionWebAuthnRemoved          AuditAction = "webauthn_removed"
+	AuditActionRoleGranted              AuditAction = "role_granted"
+	AuditActionRoleRevoked              AuditAction = "role_revoked"
 )
 
 func NewAuditLog(userID uuid.UUID, action AuditAction, ipAddress, userAgent string) *AuditLog {
@@ -48,3 +63,40 @@ func (a *AuditLog) AddMetadata(key string, value interface{}) {
 	}
 	a.Metadata[key] = value
 }
+
+// EncodeAuditLogCursor builds the opaque pagination cursor identifying this
+// row, for use as the next page's AuditLogFilter.Cursor.
+func EncodeAuditLogCursor(log *AuditLog) string {
+	raw := fmt.Sprintf("%s|%s", log.CreatedAt.Format(time.RFC3339Nano), log.ID.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeAuditLogCursor reverses EncodeAuditLogCursor. An empty cursor
+// decodes to the zero values, meaning "no constraint" (first page).
+func DecodeAuditLogCursor(cursor string) (createdAt time.Time, id uuid.UUID, err error) {
+	if cursor == "" {
+		return time.Time{}, uuid.Nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return time.Time{}, uuid.Nil, fmt.Errorf("malformed audit log cursor")
+	}
+
+	createdAt, err = time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	id, err = uuid.Parse(parts[1])
+	if err != nil {
+		return time.Time{}, uuid.Nil, err
+	}
+
+	return createdAt, id, nil
+}
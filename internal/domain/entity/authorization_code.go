@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthorizationCode is a short-lived, single-use code issued at the end of
+// the OAuth2 authorize step and redeemed at the token endpoint per RFC 7636
+// (PKCE). Only the hash of the code is persisted.
+type AuthorizationCode struct {
+	ID                  uuid.UUID
+	CodeHash            string
+	ClientID            string
+	UserID              uuid.UUID
+	RedirectURI         string
+	Scopes              []string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+	ExpiresAt           time.Time
+	UsedAt              *time.Time
+	CreatedAt           time.Time
+}
+
+func NewAuthorizationCode(codeHash, clientID string, userID uuid.UUID, redirectURI string, scopes []string, challenge, challengeMethod, nonce string, ttl time.Duration) *AuthorizationCode {
+	now := time.Now()
+	return &AuthorizationCode{
+		ID:                  uuid.Must(uuid.NewV7()),
+		CodeHash:            codeHash,
+		ClientID:            clientID,
+		UserID:              userID,
+		RedirectURI:         redirectURI,
+		Scopes:              scopes,
+		CodeChallenge:       challenge,
+		CodeChallengeMethod: challengeMethod,
+		Nonce:               nonce,
+		ExpiresAt:           now.Add(ttl),
+		CreatedAt:           now,
+	}
+}
+
+func (c *AuthorizationCode) IsValid() bool {
+	if c.UsedAt != nil {
+		return false
+	}
+	return time.Now().Before(c.ExpiresAt)
+}
+
+func (c *AuthorizationCode) MarkUsed() {
+	now := time.Now()
+	c.UsedAt = &now
+}
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Identity links a user to an external identity provider account (Google,
+// GitHub, generic OIDC), allowing a single user to authenticate through
+// more than one provider.
+type Identity struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	Provider        string
+	ProviderSubject string
+	Email           string
+	CreatedAt       time.Time
+}
+
+func NewIdentity(userID uuid.UUID, provider, providerSubject, email string) *Identity {
+	return &Identity{
+		ID:              uuid.Must(uuid.NewV7()),
+		UserID:          userID,
+		Provider:        provider,
+		ProviderSubject: providerSubject,
+		Email:           email,
+		CreatedAt:       time.Now(),
+	}
+}
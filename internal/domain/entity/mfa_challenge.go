@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFAChallenge is the short-lived, single-use token returned by Login in
+// place of a real token pair when the user has TOTP MFA enabled. It must be
+// redeemed together with a valid TOTP/recovery code at POST /login/mfa.
+type MFAChallenge struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	TokenHash  string
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func NewMFAChallenge(userID uuid.UUID, tokenHash string, ttl time.Duration) *MFAChallenge {
+	now := time.Now()
+	return &MFAChallenge{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+func (c *MFAChallenge) IsValid() bool {
+	if c.ConsumedAt != nil {
+		return false
+	}
+	return time.Now().Before(c.ExpiresAt)
+}
+
+func (c *MFAChallenge) MarkConsumed() {
+	now := time.Now()
+	c.ConsumedAt = &now
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Client represents a registered OAuth2/OIDC client application allowed to
+// perform the authorization code flow against this service.
+type Client struct {
+	ID                uuid.UUID
+	ClientID          string
+	ClientSecretHash  string
+	Name              string
+	RedirectURIs      []string
+	AllowedScopes     []string
+	AllowedGrantTypes []string
+	IsConfidential    bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func NewClient(name, clientID, clientSecretHash string, redirectURIs, allowedScopes, allowedGrantTypes []string, confidential bool) *Client {
+	now := time.Now()
+	return &Client{
+		ID:                uuid.Must(uuid.NewV7()),
+		ClientID:          clientID,
+		ClientSecretHash:  clientSecretHash,
+		Name:              name,
+		RedirectURIs:      redirectURIs,
+		AllowedScopes:     allowedScopes,
+		AllowedGrantTypes: allowedGrantTypes,
+		IsConfidential:    confidential,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+func (c *Client) AllowsRedirectURI(uri string) bool {
+	for _, allowed := range c.RedirectURIs {
+		if allowed == uri {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) AllowsGrantType(grantType string) bool {
+	for _, allowed := range c.AllowedGrantTypes {
+		if allowed == grantType {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedScopeSet filters the requested scopes down to the ones this client
+// is registered for, dropping anything it is not allowed to request.
+func (c *Client) AllowedScopeSet(requested []string) []string {
+	allowed := make(map[string]bool, len(c.AllowedScopes))
+	for _, s := range c.AllowedScopes {
+		allowed[s] = true
+	}
+
+	granted := make([]string, 0, len(requested))
+	for _, s := range requested {
+		if allowed[s] {
+			granted = append(granted, s)
+		}
+	}
+	return granted
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+)
+
+// Default role names seeded on startup and assigned automatically: every
+// new account gets DefaultRoleUser, DefaultRoleAdmin must be granted
+// explicitly through the admin API.
+const (
+	DefaultRoleUser  = "user"
+	DefaultRoleAdmin = "admin"
+)
+
+// RoleDefinition is a named, persisted set of permissions (dotted strings
+// like "users.read", "audit.export") that can be granted to a user.
+// Hierarchical: a role with a Parent inherits every permission the parent
+// grants, in addition to its own - e.g. "admin" can inherit "user".
+type RoleDefinition struct {
+	Name        string
+	Parent      string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewRoleDefinition(name, parent string, permissions []string) *RoleDefinition {
+	now := time.Now()
+	return &RoleDefinition{
+		Name:        name,
+		Parent:      parent,
+		Permissions: permissions,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Principal is the authenticated identity a request carries once
+// TokenService.ValidateAccessToken decodes the access token's role and
+// permission claims. Presentation-layer middleware (RequireRole,
+// RequirePermission) authorizes against it.
+type Principal struct {
+	UserID      string
+	Roles       []string
+	Permissions []string
+}
+
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) HasPermission(permission string) bool {
+	for _, perm := range p.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -6,37 +6,69 @@ import (
 	"github.com/google/uuid"
 )
 
+// RefreshToken is one link in a rotation chain. FamilyID is shared by every
+// token descended from the same Login/Callback and lets RefreshToken detect
+// reuse of an already-rotated token and revoke the whole chain.
+// FingerprintHash binds the token to the device it was issued to (a hash of
+// the issuing request's User-Agent and /24 IP prefix), so a refresh
+// presented from a very different client can be flagged even before it's
+// ever reused. ReplacedByID is set only when this token was consumed by a
+// rotation, as opposed to a plain logout/admin revoke - WasReplaced uses it
+// to tell "this is a stolen, already-rotated token" apart from "this is just
+// an old, intentionally revoked one".
 type RefreshToken struct {
-	ID        uuid.UUID
-	UserID    uuid.UUID
-	TokenHash string
-	ExpiresAt time.Time
-	IsRevoked bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	FamilyID        uuid.UUID
+	TokenHash       string
+	FingerprintHash string
+	ExpiresAt       time.Time
+	IsRevoked       bool
+	ReplacedByID    *uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
-func NewRefreshToken(userID uuid.UUID, tokenHash string, ttl time.Duration) *RefreshToken {
+// NewRefreshToken starts a new rotation family, for a fresh login.
+func NewRefreshToken(userID uuid.UUID, tokenHash, fingerprintHash string, ttl time.Duration) *RefreshToken {
+	return NewRotatedRefreshToken(userID, uuid.Must(uuid.NewV7()), tokenHash, fingerprintHash, ttl)
+}
+
+// NewRotatedRefreshToken issues the next token in an existing rotation
+// family, carrying familyID forward from the token being replaced.
+func NewRotatedRefreshToken(userID, familyID uuid.UUID, tokenHash, fingerprintHash string, ttl time.Duration) *RefreshToken {
 	now := time.Now()
 	return &RefreshToken{
-		ID:        uuid.Must(uuid.NewV7()),
-		UserID:    userID,
-		TokenHash: tokenHash,
-		ExpiresAt: now.Add(ttl),
-		IsRevoked: false,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:              uuid.Must(uuid.NewV7()),
+		UserID:          userID,
+		FamilyID:        familyID,
+		TokenHash:       tokenHash,
+		FingerprintHash: fingerprintHash,
+		ExpiresAt:       now.Add(ttl),
+		IsRevoked:       false,
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	}
 }
 
+func (rt *RefreshToken) IsExpired() bool {
+	return time.Now().After(rt.ExpiresAt)
+}
+
 func (rt *RefreshToken) IsValid() bool {
 	if rt.IsRevoked {
 		return false
 	}
-	if time.Now().After(rt.ExpiresAt) {
-		return false
-	}
-	return true
+	return !rt.IsExpired()
+}
+
+// WasReplaced reports whether this token was revoked specifically because it
+// was rotated into ReplacedByID. Presenting a replaced token again is
+// refresh-token reuse (the token leaked and both the thief and the
+// legitimate holder tried to use it); presenting a token revoked for another
+// reason (logout, admin action) again is not.
+func (rt *RefreshToken) WasReplaced() bool {
+	return rt.IsRevoked && rt.ReplacedByID != nil
 }
 
 func (rt *RefreshToken) Revoke() {
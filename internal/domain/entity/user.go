@@ -1,12 +1,18 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
+	domainSecurity "auth-service/internal/domain/security"
+
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 )
 
+// ErrPasswordMismatch is returned by VerifyPassword when the supplied
+// password doesn't match the stored hash.
+var ErrPasswordMismatch = errors.New("password does not match")
+
 type User struct {
 	ID                  uuid.UUID
 	Email               string
@@ -29,8 +35,8 @@ const (
 	RoleAdmin Role = "admin"
 )
 
-func NewUser(email, password string) (*User, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+func NewUser(email, password string, hasher domainSecurity.PasswordHasher) (*User, error) {
+	hashedPassword, err := hasher.Hash(password)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +45,7 @@ func NewUser(email, password string) (*User, error) {
 	return &User{
 		ID:           uuid.Must(uuid.NewV7()),
 		Email:        email,
-		PasswordHash: string(hashedPassword),
+		PasswordHash: hashedPassword,
 		Role:         RoleUser,
 		IsVerified:   false,
 		IsLocked:     false,
@@ -48,8 +54,37 @@ func NewUser(email, password string) (*User, error) {
 	}, nil
 }
 
-func (u *User) VerifyPassword(password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password))
+func (u *User) VerifyPassword(password string, hasher domainSecurity.PasswordHasher) error {
+	ok, err := hasher.Verify(u.PasswordHash, password)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// RehashPassword overwrites the stored hash with one freshly produced by
+// hasher, without requiring the caller to know the new password - used to
+// transparently upgrade a verified bcrypt or under-strength Argon2id hash
+// the next time its owner logs in.
+func (u *User) RehashPassword(password string, hasher domainSecurity.PasswordHasher) error {
+	hashedPassword, err := hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = hashedPassword
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasPassword reports whether the account can authenticate locally at all.
+// Accounts created purely through a linked identity provider (see Identity)
+// have no PasswordHash, so password/VerifyPassword flows must check this
+// first instead of surfacing bcrypt's incidental failure on an empty hash.
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
 }
 
 func (u *User) IsAccountLocked() bool {
@@ -83,14 +118,8 @@ func (u *User) UpdateLastLogin(ipAddress string) {
 	u.LastLoginIP = ipAddress
 }
 
-func (u *User) ChangePassword(newPassword string) error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
-	if err != nil {
-		return err
-	}
-	u.PasswordHash = string(hashedPassword)
-	u.UpdatedAt = time.Now()
-	return nil
+func (u *User) ChangePassword(newPassword string, hasher domainSecurity.PasswordHasher) error {
+	return u.RehashPassword(newPassword, hasher)
 }
 
 func (u *User) Verify() {
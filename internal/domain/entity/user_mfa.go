@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// UserMFA holds a user's TOTP enrollment: the encrypted shared secret plus
+// bcrypt-hashed one-time recovery codes, per RFC 6238.
+type UserMFA struct {
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	SecretEncrypted    string
+	Enabled            bool
+	RecoveryCodeHashes []string
+	LastUsedCounter    int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func NewUserMFA(userID uuid.UUID, secretEncrypted string, recoveryCodeHashes []string) *UserMFA {
+	now := time.Now()
+	return &UserMFA{
+		ID:                 uuid.Must(uuid.NewV7()),
+		UserID:             userID,
+		SecretEncrypted:    secretEncrypted,
+		Enabled:            false,
+		RecoveryCodeHashes: recoveryCodeHashes,
+		LastUsedCounter:    -1,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+}
+
+func (m *UserMFA) Confirm() {
+	m.Enabled = true
+	m.UpdatedAt = time.Now()
+}
+
+// AcceptCounter rejects replay of an already-consumed TOTP step within the
+// clock-skew window.
+func (m *UserMFA) AcceptCounter(counter int64) bool {
+	if counter <= m.LastUsedCounter {
+		return false
+	}
+	m.LastUsedCounter = counter
+	m.UpdatedAt = time.Now()
+	return true
+}
+
+// ConsumeRecoveryCode verifies the plaintext code against the stored
+// hashes and removes it on success so it cannot be reused.
+func (m *UserMFA) ConsumeRecoveryCode(plain string) bool {
+	for i, hash := range m.RecoveryCodeHashes {
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(plain)) == nil {
+			m.RecoveryCodeHashes = append(m.RecoveryCodeHashes[:i], m.RecoveryCodeHashes[i+1:]...)
+			m.UpdatedAt = time.Now()
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallengePurpose distinguishes a registration ceremony (adding a
+// new credential to an already-authenticated user) from a login ceremony
+// (second factor or full passwordless sign-in).
+type WebAuthnChallengePurpose string
+
+const (
+	WebAuthnChallengeRegistration WebAuthnChallengePurpose = "registration"
+	WebAuthnChallengeLogin        WebAuthnChallengePurpose = "login"
+)
+
+// WebAuthnChallenge holds the server-side session data go-webauthn needs
+// between a Begin call and the matching Finish call, short-lived like
+// MFAChallenge and one-time use.
+type WebAuthnChallenge struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Purpose     WebAuthnChallengePurpose
+	SessionData []byte
+	ExpiresAt   time.Time
+	ConsumedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func NewWebAuthnChallenge(userID uuid.UUID, purpose WebAuthnChallengePurpose, sessionData []byte, ttl time.Duration) *WebAuthnChallenge {
+	now := time.Now()
+	return &WebAuthnChallenge{
+		ID:          uuid.Must(uuid.NewV7()),
+		UserID:      userID,
+		Purpose:     purpose,
+		SessionData: sessionData,
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	}
+}
+
+func (c *WebAuthnChallenge) IsValid() bool {
+	if c.ConsumedAt != nil {
+		return false
+	}
+	return time.Now().Before(c.ExpiresAt)
+}
+
+func (c *WebAuthnChallenge) MarkConsumed() {
+	now := time.Now()
+	c.ConsumedAt = &now
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnCredential is one registered authenticator (security key, platform
+// passkey, etc.) for a user, per the W3C WebAuthn spec. A user with at least
+// one credential can sign in with it either as a second factor after
+// password, or - if the credential is discoverable - as a full passwordless
+// login.
+type WebAuthnCredential struct {
+	ID              uuid.UUID
+	UserID          uuid.UUID
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       uint32
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewWebAuthnCredential(userID uuid.UUID, credentialID, publicKey []byte, attestationType string, transports []string, aaguid []byte, signCount uint32) *WebAuthnCredential {
+	now := time.Now()
+	return &WebAuthnCredential{
+		ID:              uuid.Must(uuid.NewV7()),
+		UserID:          userID,
+		CredentialID:    credentialID,
+		PublicKey:       publicKey,
+		AttestationType: attestationType,
+		Transports:      transports,
+		AAGUID:          aaguid,
+		SignCount:       signCount,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// UpdateSignCount records the authenticator's signature counter after a
+// successful assertion, so a cloned authenticator presenting a stale or
+// replayed counter can be detected on the next login.
+func (c *WebAuthnCredential) UpdateSignCount(count uint32) {
+	c.SignCount = count
+	c.UpdatedAt = time.Now()
+}
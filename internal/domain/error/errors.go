@@ -11,6 +11,7 @@ var (
 	ErrAccountNotVerified    = errors.New("account is not verified")
 	ErrInvalidPassword       = errors.New("invalid password")
 	ErrWeakPassword          = errors.New("password is too weak")
+	ErrEmailAlreadyVerified  = errors.New("email is already verified")
 
 	// Token errors
 	ErrInvalidToken          = errors.New("invalid token")
@@ -31,4 +32,40 @@ var (
 
 	// Rate limit errors
 	ErrRateLimitExceeded     = errors.New("rate limit exceeded")
+
+	// OAuth2 / OIDC errors
+	ErrInvalidClient         = errors.New("invalid client")
+	ErrInvalidRedirectURI    = errors.New("invalid redirect_uri")
+	ErrInvalidScope          = errors.New("invalid scope")
+	ErrInvalidGrant          = errors.New("invalid grant")
+	ErrUnsupportedGrantType  = errors.New("unsupported grant type")
+	ErrInvalidCodeVerifier   = errors.New("invalid code_verifier")
+
+	// External identity provider errors
+	ErrProviderNotSupported    = errors.New("identity provider not supported")
+	ErrInvalidState            = errors.New("invalid or expired state parameter")
+	ErrIdentityAlreadyLinked   = errors.New("identity already linked to an account")
+	ErrLastCredential          = errors.New("cannot remove the last sign-in method")
+	ErrNoPasswordSet           = errors.New("account has no password set; sign in via a linked identity provider")
+	ErrProviderEmailUnverified = errors.New("identity provider did not assert a verified email for this account")
+
+	// MFA errors
+	ErrMFARequired       = errors.New("mfa verification required")
+	ErrMFAAlreadyEnabled = errors.New("mfa is already enabled")
+	ErrMFANotEnabled     = errors.New("mfa is not enabled")
+	ErrInvalidMFACode    = errors.New("invalid mfa code")
+	ErrInvalidMFAToken   = errors.New("invalid or expired mfa token")
+
+	// Reauthentication errors
+	ErrReauthenticationRequired = errors.New("a fresh reauthentication is required for this operation")
+
+	// WebAuthn errors
+	ErrCredentialNotFound       = errors.New("webauthn credential not found")
+	ErrInvalidWebAuthnChallenge = errors.New("invalid or expired webauthn challenge")
+	ErrNoWebAuthnCredentials    = errors.New("account has no registered webauthn credentials")
+
+	// RBAC errors
+	ErrRoleNotFound        = errors.New("role not found")
+	ErrRoleAlreadyExists   = errors.New("role already exists")
+	ErrRoleAlreadyAssigned = errors.New("role already assigned to user")
 )
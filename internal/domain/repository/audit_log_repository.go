@@ -2,10 +2,32 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
 )
 
+// AuditLogFilter narrows List to a subset of audit log entries. Zero-value
+// fields are treated as "no constraint". Cursor is an opaque token
+// (entity.EncodeAuditLogCursor) identifying the last row of the previous
+// page; leave it empty to fetch the first page.
+type AuditLogFilter struct {
+	UserID    *uuid.UUID
+	Action    *entity.AuditAction
+	IPAddress string
+	From      *time.Time
+	To        *time.Time
+	Cursor    string
+	Limit     int
+}
+
 type AuditLogRepository interface {
 	Create(ctx context.Context, log *entity.AuditLog) error
+
+	// List returns up to filter.Limit entries matching filter, newest first,
+	// together with the total count of rows matching filter (ignoring
+	// Cursor/Limit) so callers can render "N of M" pagination.
+	List(ctx context.Context, filter AuditLogFilter) (logs []*entity.AuditLog, total int64, err error)
 }
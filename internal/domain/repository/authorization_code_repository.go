@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+)
+
+type AuthorizationCodeRepository interface {
+	Create(ctx context.Context, code *entity.AuthorizationCode) error
+	FindByCodeHash(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error)
+	MarkUsed(ctx context.Context, id string) error
+}
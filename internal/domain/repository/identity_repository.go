@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type IdentityRepository interface {
+	Create(ctx context.Context, identity *entity.Identity) error
+	FindByProviderSubject(ctx context.Context, provider, providerSubject string) (*entity.Identity, error)
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Identity, error)
+	DeleteByUserIDAndProvider(ctx context.Context, userID uuid.UUID, provider string) error
+	CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+}
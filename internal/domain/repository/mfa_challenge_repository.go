@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type MFAChallengeRepository interface {
+	Create(ctx context.Context, challenge *entity.MFAChallenge) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.MFAChallenge, error)
+	MarkConsumed(ctx context.Context, id uuid.UUID) error
+	DeleteExpired(ctx context.Context) error
+}
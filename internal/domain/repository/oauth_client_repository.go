@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+)
+
+type ClientRepository interface {
+	Create(ctx context.Context, client *entity.Client) error
+	FindByClientID(ctx context.Context, clientID string) (*entity.Client, error)
+}
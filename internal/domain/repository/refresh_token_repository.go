@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entity.RefreshToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, error)
+	RevokeByTokenHash(ctx context.Context, tokenHash string) error
+	// RevokeAndReplace atomically revokes the token at tokenHash and records
+	// replacedByID as the token it was rotated into, so a later replay of
+	// tokenHash can be told apart from replay of a token revoked for some
+	// other reason (logout, admin action).
+	RevokeAndReplace(ctx context.Context, tokenHash string, replacedByID uuid.UUID) error
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	DeleteExpired(ctx context.Context) error
+}
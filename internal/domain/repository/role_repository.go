@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+// RoleRepository persists role definitions and which roles each user holds.
+type RoleRepository interface {
+	Create(ctx context.Context, role *entity.RoleDefinition) error
+	FindByName(ctx context.Context, name string) (*entity.RoleDefinition, error)
+	List(ctx context.Context) ([]*entity.RoleDefinition, error)
+	Delete(ctx context.Context, name string) error
+
+	AssignToUser(ctx context.Context, userID uuid.UUID, roleName string) error
+	RevokeFromUser(ctx context.Context, userID uuid.UUID, roleName string) error
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]string, error)
+}
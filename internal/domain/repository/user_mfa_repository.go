@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type UserMFARepository interface {
+	Create(ctx context.Context, mfa *entity.UserMFA) error
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error)
+	Update(ctx context.Context, mfa *entity.UserMFA) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
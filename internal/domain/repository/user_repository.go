@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type UserRepository interface {
+	Create(ctx context.Context, user *entity.User) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	FindByEmail(ctx context.Context, email string) (*entity.User, error)
+	Update(ctx context.Context, user *entity.User) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type VerificationTokenRepository interface {
+	Create(ctx context.Context, token *entity.VerificationToken) error
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.VerificationToken, error)
+	// MarkConsumed atomically claims an unconsumed token, returning
+	// ErrInvalidToken if it was already consumed by a concurrent redemption.
+	MarkConsumed(ctx context.Context, id uuid.UUID) error
+	InvalidateByUserIDAndPurpose(ctx context.Context, userID uuid.UUID, purpose entity.VerificationPurpose) error
+	DeleteExpired(ctx context.Context) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type WebAuthnChallengeRepository interface {
+	Create(ctx context.Context, challenge *entity.WebAuthnChallenge) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WebAuthnChallenge, error)
+	MarkConsumed(ctx context.Context, id uuid.UUID) error
+	DeleteExpired(ctx context.Context) error
+}
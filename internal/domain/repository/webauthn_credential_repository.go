@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/google/uuid"
+)
+
+type WebAuthnCredentialRepository interface {
+	Create(ctx context.Context, credential *entity.WebAuthnCredential) error
+	FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.WebAuthnCredential, error)
+	FindByCredentialID(ctx context.Context, credentialID []byte) (*entity.WebAuthnCredential, error)
+	UpdateSignCount(ctx context.Context, id uuid.UUID, signCount uint32) error
+	DeleteByID(ctx context.Context, id uuid.UUID) error
+}
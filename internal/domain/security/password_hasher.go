@@ -0,0 +1,16 @@
+// Package security holds the cryptographic contracts domain entities rely
+// on without depending on a concrete algorithm. Keeping PasswordHasher here
+// rather than in infrastructure/security lets entity.User call it directly,
+// the same way it already depends on domain/error.
+package security
+
+// PasswordHasher hashes and verifies passwords, and reports whether an
+// existing hash should be upgraded to the current policy. Storing the
+// chosen algorithm and its parameters inside the hash string (PHC format)
+// is what lets NeedsRehash compare against the live config instead of a
+// hash produced by some other policy entirely.
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(hash, password string) (bool, error)
+	NeedsRehash(hash string) bool
+}
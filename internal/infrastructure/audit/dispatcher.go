@@ -0,0 +1,137 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// Dispatcher batches audit events through a bounded channel and flushes
+// them to every configured Sink in parallel, so a slow or unavailable sink
+// (a webhook endpoint down, a full disk) never blocks the request path
+// that called Record.
+type Dispatcher struct {
+	sinks         []Sink
+	flushSize     int
+	flushInterval time.Duration
+	log           *logger.Logger
+
+	events chan *entity.AuditLog
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher builds a Dispatcher and starts its flush loop. bufferSize
+// bounds how many events may be queued before Record starts dropping them;
+// flushSize/flushInterval bound how long an event waits before being
+// written (whichever limit is hit first).
+func NewDispatcher(sinks []Sink, flushSize int, flushInterval time.Duration, bufferSize int, log *logger.Logger) *Dispatcher {
+	d := &Dispatcher{
+		sinks:         sinks,
+		flushSize:     flushSize,
+		flushInterval: flushInterval,
+		log:           log,
+		events:        make(chan *entity.AuditLog, bufferSize),
+		done:          make(chan struct{}),
+	}
+
+	d.wg.Add(1)
+	go d.run()
+
+	return d
+}
+
+// Record enqueues an event for async delivery. It never blocks: if the
+// buffer is full the event is dropped and a warning is logged, trading
+// audit completeness for request-path latency under sustained overload.
+func (d *Dispatcher) Record(ctx context.Context, log *entity.AuditLog) {
+	select {
+	case d.events <- log:
+	default:
+		d.log.Warn("audit: buffer full, dropping event",
+			zap.String("action", string(log.Action)),
+			zap.String("user_id", log.UserID.String()),
+		)
+	}
+}
+
+func (d *Dispatcher) run() {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(d.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*entity.AuditLog, 0, d.flushSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		d.flush(batch)
+		batch = make([]*entity.AuditLog, 0, d.flushSize)
+	}
+
+	for {
+		select {
+		case log := <-d.events:
+			batch = append(batch, log)
+			if len(batch) >= d.flushSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-d.done:
+			// Drain whatever is already queued before exiting.
+			for {
+				select {
+				case log := <-d.events:
+					batch = append(batch, log)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) flush(batch []*entity.AuditLog) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, sink := range d.sinks {
+		wg.Add(1)
+		go func(sink Sink) {
+			defer wg.Done()
+			if err := sink.Write(ctx, batch); err != nil {
+				d.log.Error("audit: sink write failed", zap.Error(err))
+			}
+		}(sink)
+	}
+	wg.Wait()
+}
+
+// Shutdown stops accepting new flush ticks, flushes whatever is still
+// queued, and waits for that final flush to complete. Call this before the
+// process exits so in-flight audit events aren't silently lost.
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	close(d.done)
+
+	waited := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(waited)
+	}()
+
+	select {
+	case <-waited:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
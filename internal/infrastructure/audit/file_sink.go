@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"auth-service/internal/domain/entity"
+)
+
+// FileSink appends each event to path as a JSON line, for local inspection
+// or tailing into a log-shipping agent.
+type FileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Write(ctx context.Context, logs []*entity.AuditLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open audit log file: %w", err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, log := range logs {
+		if err := enc.Encode(log); err != nil {
+			return fmt.Errorf("write audit log entry: %w", err)
+		}
+	}
+	return nil
+}
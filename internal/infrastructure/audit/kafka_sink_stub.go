@@ -0,0 +1,27 @@
+//go:build !kafka
+
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"auth-service/internal/domain/entity"
+)
+
+// KafkaSink is a stub used when the service is built without the "kafka"
+// build tag. It exists so AUDIT_SINKS=kafka fails loudly at startup instead
+// of the binary silently refusing to build for anyone who isn't using it.
+type KafkaSink struct{}
+
+func NewKafkaSink(brokers []string, topic string) *KafkaSink {
+	return &KafkaSink{}
+}
+
+func (s *KafkaSink) Write(ctx context.Context, logs []*entity.AuditLog) error {
+	return fmt.Errorf("audit: kafka sink requires building with -tags kafka")
+}
+
+func (s *KafkaSink) Close() error {
+	return nil
+}
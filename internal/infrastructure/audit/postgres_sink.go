@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/domain/repository"
+)
+
+// PostgresSink writes each event through the existing AuditLogRepository,
+// preserving the previous synchronous-write behavior, just off the request
+// path now.
+type PostgresSink struct {
+	repo repository.AuditLogRepository
+}
+
+func NewPostgresSink(repo repository.AuditLogRepository) *PostgresSink {
+	return &PostgresSink{repo: repo}
+}
+
+func (s *PostgresSink) Write(ctx context.Context, logs []*entity.AuditLog) error {
+	var firstErr error
+	for _, log := range logs {
+		if err := s.repo.Create(ctx, log); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
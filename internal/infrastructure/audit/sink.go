@@ -0,0 +1,14 @@
+package audit
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+)
+
+// Sink durably records a batch of audit events somewhere. Write is called
+// by the Dispatcher's flush loop, never on the request path, so it's free
+// to block on I/O.
+type Sink interface {
+	Write(ctx context.Context, logs []*entity.AuditLog) error
+}
@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"auth-service/internal/domain/entity"
+)
+
+// WebhookSink POSTs each flushed batch as JSON to url, signing the body
+// with HMAC-SHA256 so the receiving SIEM can verify it actually came from
+// this service.
+type WebhookSink struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewWebhookSink(url, secret string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *WebhookSink) Write(ctx context.Context, logs []*entity.AuditLog) error {
+	body, err := json.Marshal(logs)
+	if err != nil {
+		return fmt.Errorf("marshal audit log batch: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Audit-Signature", s.sign(body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver audit webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("audit webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *WebhookSink) sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(s.secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
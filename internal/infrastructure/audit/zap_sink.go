@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+
+	"auth-service/internal/domain/entity"
+	"auth-service/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// ZapSink writes each event as a structured log line through the service's
+// own logger, so audit events land wherever the rest of the application's
+// logs already go (stdout in development, the configured log shipper in
+// production) without standing up a separate file or webhook.
+type ZapSink struct {
+	log *logger.Logger
+}
+
+func NewZapSink(log *logger.Logger) *ZapSink {
+	return &ZapSink{log: log}
+}
+
+func (s *ZapSink) Write(ctx context.Context, logs []*entity.AuditLog) error {
+	for _, l := range logs {
+		s.log.Info("audit_event",
+			zap.String("id", l.ID.String()),
+			zap.String("user_id", l.UserID.String()),
+			zap.String("action", string(l.Action)),
+			zap.String("ip_address", l.IPAddress),
+			zap.String("user_agent", l.UserAgent),
+			zap.Any("metadata", l.Metadata),
+			zap.Time("created_at", l.CreatedAt),
+		)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -16,6 +17,13 @@ type Config struct {
 	Cookie      CookieConfig
 	Security    SecurityConfig
 	Redis       RedisConfig
+	OIDC        OIDCConfig
+	Providers   ProvidersConfig
+	MFA         MFAConfig
+	WebAuthn    WebAuthnConfig
+	Password    PasswordConfig
+	Mail        MailConfig
+	Audit       AuditConfig
 }
 
 type ServerConfig struct {
@@ -61,6 +69,95 @@ type RedisConfig struct {
 	DB       int
 }
 
+// OIDCConfig configures the authorization-code + PKCE identity provider
+// mode (/oauth2/*, /.well-known/*). Issuer is the `iss` claim on id_tokens;
+// RSAPrivateKeyPEM signs them and backs the JWKS endpoint. KeyRotationGrace
+// is how long a retired signing key stays published in JWKS after
+// OIDCSigner.RotateKey, so tokens signed just before a rotation still verify.
+type OIDCConfig struct {
+	Issuer           string
+	RSAPrivateKeyPEM string
+	AuthCodeTTL      time.Duration
+	IDTokenTTL       time.Duration
+	KeyRotationGrace time.Duration
+}
+
+// ProvidersConfig registers this service as an OAuth2 client of external
+// identity providers for social login + account linking.
+type ProvidersConfig struct {
+	StateSecret   string
+	StateTTL      time.Duration
+	Google        ProviderClientConfig
+	GitHub        ProviderClientConfig
+	GenericOIDC   ProviderClientConfig
+	GenericIssuer string
+}
+
+type ProviderClientConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+// MFAConfig configures TOTP-based multi-factor authentication. EncryptionKey
+// must be exactly 32 bytes and encrypts stored TOTP secrets at rest.
+type MFAConfig struct {
+	EncryptionKey  string
+	TokenTTL       time.Duration
+	RecoveryCodes  int
+	SensitiveOpTTL time.Duration
+}
+
+// WebAuthnConfig configures passkey/security-key registration and login.
+// RPID must be the bare domain (no scheme/port) shared by every origin in
+// RPOrigins, per the WebAuthn relying-party rules.
+type WebAuthnConfig struct {
+	RPID          string
+	RPDisplayName string
+	RPOrigins     []string
+	ChallengeTTL  time.Duration
+}
+
+// PasswordConfig tunes the Argon2id cost factors new password hashes are
+// created with. Raising these only affects future hashes - existing ones
+// keep verifying under whatever parameters they were created with, and get
+// rehashed under the new policy the next time their owner logs in.
+type PasswordConfig struct {
+	Argon2Memory      uint32 // KiB
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+// MailConfig configures the transactional mail sent for email verification
+// and password resets. Transport selects "smtp" or "console" (the default,
+// logging mail instead of delivering it - useful without an SMTP relay).
+type MailConfig struct {
+	Transport             string
+	SMTPHost              string
+	SMTPPort              string
+	SMTPUsername          string
+	SMTPPassword          string
+	From                  string
+	AppBaseURL            string
+	VerificationTokenTTL  time.Duration
+	PasswordResetTokenTTL time.Duration
+}
+
+// AuditConfig configures where AuthUseCase's audit events end up and how
+// the async dispatcher batches them before writing. Sinks may name more
+// than one backend ("postgres,webhook") - every event goes to all of them.
+type AuditConfig struct {
+	Sinks         []string
+	FlushSize     int
+	FlushInterval time.Duration
+	BufferSize    int
+	FilePath      string
+	WebhookURL    string
+	WebhookSecret string
+	KafkaBrokers  []string
+	KafkaTopic    string
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
@@ -103,6 +200,72 @@ func Load() (*Config, error) {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       parseInt(getEnv("REDIS_DB", "0")),
 		},
+		OIDC: OIDCConfig{
+			Issuer:           getEnv("OIDC_ISSUER", "http://localhost:9001"),
+			RSAPrivateKeyPEM: getEnv("OIDC_RSA_PRIVATE_KEY", ""),
+			AuthCodeTTL:      parseDuration(getEnv("OIDC_AUTH_CODE_TTL", "1m")),
+			IDTokenTTL:       parseDuration(getEnv("OIDC_ID_TOKEN_TTL", "15m")),
+			KeyRotationGrace: parseDuration(getEnv("OIDC_KEY_ROTATION_GRACE", "24h")),
+		},
+		Providers: ProvidersConfig{
+			StateSecret: getEnv("OAUTH_STATE_SECRET", ""),
+			StateTTL:    parseDuration(getEnv("OAUTH_STATE_TTL", "10m")),
+			Google: ProviderClientConfig{
+				ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
+				ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
+				RedirectURL:  getEnv("GOOGLE_REDIRECT_URL", ""),
+			},
+			GitHub: ProviderClientConfig{
+				ClientID:     getEnv("GITHUB_CLIENT_ID", ""),
+				ClientSecret: getEnv("GITHUB_CLIENT_SECRET", ""),
+				RedirectURL:  getEnv("GITHUB_REDIRECT_URL", ""),
+			},
+			GenericOIDC: ProviderClientConfig{
+				ClientID:     getEnv("OIDC_CLIENT_ID", ""),
+				ClientSecret: getEnv("OIDC_CLIENT_SECRET", ""),
+				RedirectURL:  getEnv("OIDC_CLIENT_REDIRECT_URL", ""),
+			},
+			GenericIssuer: getEnv("OIDC_GENERIC_ISSUER", ""),
+		},
+		MFA: MFAConfig{
+			EncryptionKey:  getEnv("MFA_ENCRYPTION_KEY", ""),
+			TokenTTL:       parseDuration(getEnv("MFA_TOKEN_TTL", "5m")),
+			RecoveryCodes:  parseInt(getEnv("MFA_RECOVERY_CODES", "10")),
+			SensitiveOpTTL: parseDuration(getEnv("MFA_SENSITIVE_OP_TTL", "5m")),
+		},
+		WebAuthn: WebAuthnConfig{
+			RPID:          getEnv("WEBAUTHN_RP_ID", "localhost"),
+			RPDisplayName: getEnv("WEBAUTHN_RP_DISPLAY_NAME", "Auth Service"),
+			RPOrigins:     parseStringSlice(getEnv("WEBAUTHN_RP_ORIGINS", "http://localhost:3000")),
+			ChallengeTTL:  parseDuration(getEnv("WEBAUTHN_CHALLENGE_TTL", "5m")),
+		},
+		Password: PasswordConfig{
+			Argon2Memory:      uint32(parseInt(getEnv("ARGON2_MEMORY_KB", "65536"))),
+			Argon2Iterations:  uint32(parseInt(getEnv("ARGON2_ITERATIONS", "3"))),
+			Argon2Parallelism: uint8(parseInt(getEnv("ARGON2_PARALLELISM", "2"))),
+		},
+		Mail: MailConfig{
+			Transport:             getEnv("MAIL_TRANSPORT", "console"),
+			SMTPHost:              getEnv("SMTP_HOST", ""),
+			SMTPPort:              getEnv("SMTP_PORT", "587"),
+			SMTPUsername:          getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:          getEnv("SMTP_PASSWORD", ""),
+			From:                  getEnv("MAIL_FROM", "no-reply@auth-service.local"),
+			AppBaseURL:            getEnv("APP_BASE_URL", "http://localhost:3000"),
+			VerificationTokenTTL:  parseDuration(getEnv("EMAIL_VERIFICATION_TTL", "24h")),
+			PasswordResetTokenTTL: parseDuration(getEnv("PASSWORD_RESET_TTL", "1h")),
+		},
+		Audit: AuditConfig{
+			Sinks:         parseCommaSeparated(getEnv("AUDIT_SINKS", "postgres")),
+			FlushSize:     parseInt(getEnv("AUDIT_FLUSH_SIZE", "20")),
+			FlushInterval: parseDuration(getEnv("AUDIT_FLUSH_INTERVAL", "5s")),
+			BufferSize:    parseInt(getEnv("AUDIT_BUFFER_SIZE", "1000")),
+			FilePath:      getEnv("AUDIT_FILE_PATH", "./audit.log"),
+			WebhookURL:    getEnv("AUDIT_WEBHOOK_URL", ""),
+			WebhookSecret: getEnv("AUDIT_WEBHOOK_SECRET", ""),
+			KafkaBrokers:  parseCommaSeparated(getEnv("AUDIT_KAFKA_BROKERS", "")),
+			KafkaTopic:    getEnv("AUDIT_KAFKA_TOPIC", "auth.audit-log"),
+		},
 	}, nil
 }
 
@@ -135,6 +298,20 @@ func parseStringSlice(s string) []string {
 	return []string{s}
 }
 
+func parseCommaSeparated(s string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	result := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
 func (c *Config) Validate() error {
 	if c.Database.URL == "" {
 		return ErrMissingDatabaseURL
@@ -0,0 +1,21 @@
+package logger
+
+import "context"
+
+type contextKey struct{}
+
+// WithContext returns a copy of ctx carrying log, retrievable via
+// FromContext. Presentation-layer middleware stashes a per-request logger
+// this way so usecases can log business events with request correlation.
+func WithContext(ctx context.Context, log *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, log)
+}
+
+// FromContext returns the logger stashed by WithContext, or a no-op logger
+// if none was attached (e.g. in tests that build a bare context.Background()).
+func FromContext(ctx context.Context) *Logger {
+	if log, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return log
+	}
+	return noop
+}
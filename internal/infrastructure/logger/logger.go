@@ -11,6 +11,10 @@ type Logger struct {
 	*zap.Logger
 }
 
+// noop is returned by FromContext when no request-scoped logger was
+// attached, so callers never need a nil check.
+var noop = &Logger{zap.NewNop()}
+
 func NewLogger(env string) (*Logger, error) {
 	var config zap.Config
 
@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"context"
+
+	"auth-service/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// ConsoleTransport logs outgoing mail instead of delivering it, so auth
+// flows are exercisable in development without a real SMTP relay.
+type ConsoleTransport struct {
+	logger *logger.Logger
+}
+
+func NewConsoleTransport(logger *logger.Logger) *ConsoleTransport {
+	return &ConsoleTransport{logger: logger}
+}
+
+func (t *ConsoleTransport) Send(ctx context.Context, to, subject, body string) error {
+	t.logger.Info("Outgoing mail (console transport)",
+		zap.String("to", to),
+		zap.String("subject", subject),
+		zap.String("body", body),
+	)
+	return nil
+}
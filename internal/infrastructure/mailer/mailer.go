@@ -0,0 +1,46 @@
+// Package mailer sends the transactional emails auth flows depend on
+// (address verification, password reset) through a pluggable Transport so
+// the service can run against real SMTP in production and a console
+// transport in development without touching the call sites.
+package mailer
+
+import (
+	"context"
+	"fmt"
+)
+
+// Transport delivers a single plain-text email. Concrete implementations
+// (SMTP, console) only need to satisfy this.
+type Transport interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+// Mailer renders auth-related email content and hands it to a Transport.
+// It implements usecase.Mailer.
+type Mailer struct {
+	transport  Transport
+	from       string
+	appBaseURL string
+}
+
+func NewMailer(transport Transport, from, appBaseURL string) *Mailer {
+	return &Mailer{
+		transport:  transport,
+		from:       from,
+		appBaseURL: appBaseURL,
+	}
+}
+
+func (m *Mailer) SendVerificationEmail(ctx context.Context, to, token string) error {
+	link := fmt.Sprintf("%s/verify-email?token=%s", m.appBaseURL, token)
+	subject := "Verify your email address"
+	body := fmt.Sprintf("Welcome! Please verify your email address by visiting:\n\n%s\n\nThis link expires shortly, and can only be used once.", link)
+	return m.transport.Send(ctx, to, subject, body)
+}
+
+func (m *Mailer) SendPasswordResetEmail(ctx context.Context, to, token string) error {
+	link := fmt.Sprintf("%s/reset-password?token=%s", m.appBaseURL, token)
+	subject := "Reset your password"
+	body := fmt.Sprintf("We received a request to reset your password. Visit the link below to choose a new one:\n\n%s\n\nIf you did not request this, you can safely ignore this email.", link)
+	return m.transport.Send(ctx, to, subject, body)
+}
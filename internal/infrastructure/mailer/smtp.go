@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPConfig holds the connection details for an outgoing mail relay.
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// SMTPTransport sends mail through a standard SMTP relay, authenticating
+// with PLAIN auth when credentials are configured.
+type SMTPTransport struct {
+	config SMTPConfig
+}
+
+func NewSMTPTransport(config SMTPConfig) *SMTPTransport {
+	return &SMTPTransport{config: config}
+}
+
+func (t *SMTPTransport) Send(ctx context.Context, to, subject, body string) error {
+	addr := fmt.Sprintf("%s:%s", t.config.Host, t.config.Port)
+
+	var auth smtp.Auth
+	if t.config.Username != "" {
+		auth = smtp.PlainAuth("", t.config.Username, t.config.Password, t.config.Host)
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n", t.config.From, to, subject, body)
+
+	return smtp.SendMail(addr, auth, t.config.From, []string{to}, []byte(msg))
+}
@@ -0,0 +1,70 @@
+package oauthprovider
+
+import (
+	"context"
+	"strconv"
+)
+
+const (
+	githubAuthEndpoint     = "https://github.com/login/oauth/authorize"
+	githubTokenEndpoint    = "https://github.com/login/oauth/access_token"
+	githubUserInfoEndpoint = "https://api.github.com/user"
+	githubEmailsEndpoint   = "https://api.github.com/user/emails"
+)
+
+type GitHubProvider struct {
+	config Config
+}
+
+func NewGitHubProvider(config Config) *GitHubProvider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"read:user", "user:email"}
+	}
+	return &GitHubProvider{config: config}
+}
+
+func (p *GitHubProvider) Name() string { return "github" }
+
+// AuthURL ignores nonce: GitHub's authorize endpoint has no id_token and
+// would reject an unrecognized parameter, so CSRF protection here relies
+// solely on state.
+func (p *GitHubProvider) AuthURL(state, nonce, codeChallenge string) string {
+	return buildAuthURL(githubAuthEndpoint, p.config.ClientID, p.config.RedirectURL, joinScopes(p.config.Scopes), state, "", codeChallenge)
+}
+
+// Exchange ignores nonce: GitHub issues no id_token to carry one. Instead of
+// trusting /user's unverified email field, it looks up the verified primary
+// address from /user/emails so callers can gate auto-linking on it.
+func (p *GitHubProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (UserInfo, error) {
+	accessToken, _, err := exchangeCode(ctx, githubTokenEndpoint, p.config.ClientID, p.config.ClientSecret, p.config.RedirectURL, code, codeVerifier)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	var user struct {
+		ID int `json:"id"`
+	}
+	if err := fetchJSON(ctx, githubUserInfoEndpoint, accessToken, &user); err != nil {
+		return UserInfo{}, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := fetchJSON(ctx, githubEmailsEndpoint, accessToken, &emails); err != nil {
+		return UserInfo{}, err
+	}
+
+	var email string
+	var emailVerified bool
+	for _, e := range emails {
+		if e.Primary {
+			email, emailVerified = e.Email, e.Verified
+			break
+		}
+	}
+
+	return UserInfo{Subject: strconv.Itoa(user.ID), Email: email, EmailVerified: emailVerified}, nil
+}
@@ -0,0 +1,41 @@
+package oauthprovider
+
+import "context"
+
+const (
+	googleIssuer        = "https://accounts.google.com"
+	googleAuthEndpoint  = "https://accounts.google.com/o/oauth2/v2/auth"
+	googleTokenEndpoint = "https://oauth2.googleapis.com/token"
+	googleJWKSEndpoint  = "https://www.googleapis.com/oauth2/v3/certs"
+)
+
+type GoogleProvider struct {
+	config Config
+}
+
+func NewGoogleProvider(config Config) *GoogleProvider {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &GoogleProvider{config: config}
+}
+
+func (p *GoogleProvider) Name() string { return "google" }
+
+func (p *GoogleProvider) AuthURL(state, nonce, codeChallenge string) string {
+	return buildAuthURL(googleAuthEndpoint, p.config.ClientID, p.config.RedirectURL, joinScopes(p.config.Scopes), state, nonce, codeChallenge)
+}
+
+func (p *GoogleProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (UserInfo, error) {
+	_, idToken, err := exchangeCode(ctx, googleTokenEndpoint, p.config.ClientID, p.config.ClientSecret, p.config.RedirectURL, code, codeVerifier)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	sub, email, emailVerified, err := verifyIDToken(ctx, googleJWKSEndpoint, googleIssuer, p.config.ClientID, idToken, nonce)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	return UserInfo{Subject: sub, Email: email, EmailVerified: emailVerified}, nil
+}
@@ -0,0 +1,117 @@
+package oauthprovider
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwksDoc struct {
+	Keys []jwk `json:"keys"`
+}
+
+// verifyIDToken checks idToken's RS256 signature against jwksURI, and that
+// its iss/aud/exp claims match issuer/clientID/now. It also requires the
+// id_token's nonce claim to equal nonce, which is how replaying a login's
+// authorization code (or id_token) from one browser session into another
+// is caught — unless nonce is empty, meaning the caller has no nonce to
+// check against (LinkIdentity's client-driven authorize request, which
+// doesn't go through our state/nonce issuance). It returns the subject,
+// email, and the provider's own email_verified assertion.
+func verifyIDToken(ctx context.Context, jwksURI, issuer, clientID, idToken, nonce string) (sub, email string, emailVerified bool, err error) {
+	keys, err := fetchJWKS(ctx, jwksURI)
+	if err != nil {
+		return "", "", false, err
+	}
+
+	token, err := jwt.Parse(idToken, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key := keys[kid]
+		if key == nil {
+			return nil, errors.New("unknown id_token signing key")
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{"RS256"}), jwt.WithIssuer(issuer), jwt.WithAudience(clientID))
+	if err != nil {
+		return "", "", false, fmt.Errorf("verify id_token: %w", err)
+	}
+	if !token.Valid {
+		return "", "", false, errors.New("invalid id_token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", "", false, errors.New("invalid id_token claims")
+	}
+
+	if nonce != "" {
+		if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+			return "", "", false, errors.New("id_token nonce mismatch")
+		}
+	}
+
+	sub, _ = claims["sub"].(string)
+	if sub == "" {
+		return "", "", false, errors.New("sub missing in id_token")
+	}
+	email, _ = claims["email"].(string)
+	emailVerified, _ = claims["email_verified"].(bool)
+
+	return sub, email, emailVerified, nil
+}
+
+func fetchJWKS(ctx context.Context, jwksURI string) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks fetch failed with status %d", resp.StatusCode)
+	}
+
+	var doc jwksDoc
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(nBytes),
+			E: int(new(big.Int).SetBytes(eBytes).Int64()),
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,78 @@
+package oauthprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// OIDCProvider is a generic OpenID Connect provider that discovers its
+// endpoints from Issuer + "/.well-known/openid-configuration" instead of
+// hardcoding them, so any compliant IdP (Keycloak, Auth0, Okta, ...) can be
+// registered without a dedicated implementation.
+type OIDCProvider struct {
+	config        Config
+	issuer        string
+	authEndpoint  string
+	tokenEndpoint string
+	jwksURI       string
+}
+
+func NewOIDCProvider(ctx context.Context, issuer string, config Config) (*OIDCProvider, error) {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery failed with status %d", resp.StatusCode)
+	}
+
+	var discovery struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return nil, err
+	}
+
+	return &OIDCProvider{
+		config:        config,
+		issuer:        issuer,
+		authEndpoint:  discovery.AuthorizationEndpoint,
+		tokenEndpoint: discovery.TokenEndpoint,
+		jwksURI:       discovery.JWKSURI,
+	}, nil
+}
+
+func (p *OIDCProvider) Name() string { return "oidc" }
+
+func (p *OIDCProvider) AuthURL(state, nonce, codeChallenge string) string {
+	return buildAuthURL(p.authEndpoint, p.config.ClientID, p.config.RedirectURL, joinScopes(p.config.Scopes), state, nonce, codeChallenge)
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (UserInfo, error) {
+	_, idToken, err := exchangeCode(ctx, p.tokenEndpoint, p.config.ClientID, p.config.ClientSecret, p.config.RedirectURL, code, codeVerifier)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	sub, email, emailVerified, err := verifyIDToken(ctx, p.jwksURI, p.issuer, p.config.ClientID, idToken, nonce)
+	if err != nil {
+		return UserInfo{}, err
+	}
+
+	return UserInfo{Subject: sub, Email: email, EmailVerified: emailVerified}, nil
+}
@@ -0,0 +1,158 @@
+// Package oauthprovider implements external identity providers (Google,
+// GitHub, generic OIDC) used for social login and account linking.
+package oauthprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// UserInfo is the normalized, provider-verified profile returned by every
+// provider regardless of its native userinfo/id_token schema. EmailVerified
+// reflects the provider's own assertion (id_token's email_verified claim,
+// or GitHub's /user/emails "verified" flag) — callers must not auto-link an
+// account by Email unless this is true.
+type UserInfo struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// Provider is implemented by every concrete external identity backend and
+// satisfies usecase.LoginProvider structurally. Exchange does the full
+// authorization_code exchange plus whatever identity verification the
+// provider supports (id_token signature + nonce for OIDC-compliant
+// providers, /user/emails for GitHub which has no id_token) in one call,
+// so a caller never handles a bare access token it could mistake for a
+// verified identity.
+type Provider interface {
+	Name() string
+	AuthURL(state, nonce, codeChallenge string) string
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (UserInfo, error)
+}
+
+// Adapter exposes a Provider through plain string/error return values so it
+// satisfies usecase.LoginProvider without the usecase package depending on
+// this infrastructure package's types.
+type Adapter struct {
+	provider Provider
+}
+
+func NewAdapter(p Provider) Adapter {
+	return Adapter{provider: p}
+}
+
+func (a Adapter) Name() string { return a.provider.Name() }
+
+func (a Adapter) AuthURL(state, nonce, codeChallenge string) string {
+	return a.provider.AuthURL(state, nonce, codeChallenge)
+}
+
+func (a Adapter) Exchange(ctx context.Context, code, codeVerifier, nonce string) (subject, email string, emailVerified bool, err error) {
+	info, err := a.provider.Exchange(ctx, code, codeVerifier, nonce)
+	if err != nil {
+		return "", "", false, err
+	}
+	return info.Subject, info.Email, info.EmailVerified, nil
+}
+
+// Config holds the OAuth2 client registration for one provider.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+func buildAuthURL(authEndpoint, clientID, redirectURL, scope, state, nonce, codeChallenge string) string {
+	q := url.Values{}
+	q.Set("client_id", clientID)
+	q.Set("redirect_uri", redirectURL)
+	q.Set("response_type", "code")
+	q.Set("scope", scope)
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	return authEndpoint + "?" + q.Encode()
+}
+
+// exchangeCode runs the authorization_code grant and returns both the
+// access token and, for OIDC-compliant providers, the id_token alongside it.
+func exchangeCode(ctx context.Context, tokenEndpoint, clientID, clientSecret, redirectURL, code, codeVerifier string) (accessToken, idToken string, err error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("client_id", clientID)
+	form.Set("client_secret", clientSecret)
+	form.Set("redirect_uri", redirectURL)
+	form.Set("code", code)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, nil)
+	if err != nil {
+		return "", "", err
+	}
+	req.URL.RawQuery = form.Encode()
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("token exchange failed with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", "", err
+	}
+
+	return payload.AccessToken, payload.IDToken, nil
+}
+
+func fetchJSON(ctx context.Context, endpoint, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("userinfo request failed with status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func joinScopes(scopes []string) string {
+	out := ""
+	for i, s := range scopes {
+		if i > 0 {
+			out += " "
+		}
+		out += s
+	}
+	return out
+}
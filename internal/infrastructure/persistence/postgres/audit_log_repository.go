@@ -7,6 +7,7 @@ import (
 
 	"auth-service/internal/domain/entity"
 	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/domain/repository"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -42,6 +43,72 @@ func (r *AuditLogRepository) Create(ctx context.Context, log *entity.AuditLog) e
 	return nil
 }
 
+// List applies filter's constraints, orders newest-first, and paginates by
+// (created_at, id) cursor rather than OFFSET so deep pages stay cheap.
+func (r *AuditLogRepository) List(ctx context.Context, filter repository.AuditLogFilter) ([]*entity.AuditLog, int64, error) {
+	query := r.db.WithContext(ctx).Model(&AuditLogModel{})
+
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Action != nil {
+		query = query.Where("action = ?", string(*filter.Action))
+	}
+	if filter.IPAddress != "" {
+		query = query.Where("ip_address = ?", filter.IPAddress)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, domainErr.ErrDatabaseOperation
+	}
+
+	if filter.Cursor != "" {
+		createdAt, id, err := entity.DecodeAuditLogCursor(filter.Cursor)
+		if err != nil {
+			return nil, 0, domainErr.ErrInvalidInput
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, id)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	var models []AuditLogModel
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, 0, domainErr.ErrDatabaseOperation
+	}
+
+	logs := make([]*entity.AuditLog, len(models))
+	for i, model := range models {
+		logs[i] = r.toEntity(&model)
+	}
+	return logs, total, nil
+}
+
+func (r *AuditLogRepository) toEntity(m *AuditLogModel) *entity.AuditLog {
+	var metadata map[string]interface{}
+	_ = json.Unmarshal([]byte(m.Metadata), &metadata)
+
+	return &entity.AuditLog{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Action:    entity.AuditAction(m.Action),
+		IPAddress: m.IPAddress,
+		UserAgent: m.UserAgent,
+		Metadata:  metadata,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
 func (r *AuditLogRepository) toModel(e *entity.AuditLog) *AuditLogModel {
 	metadataJSON, _ := json.Marshal(e.Metadata)
 	return &AuditLogModel{
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuthorizationCodeModel struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CodeHash            string    `gorm:"uniqueIndex;not null"`
+	ClientID            string    `gorm:"index;not null"`
+	UserID              uuid.UUID `gorm:"type:uuid;not null;index"`
+	RedirectURI         string    `gorm:"not null"`
+	Scopes              string    `gorm:"type:text"`
+	CodeChallenge       string    `gorm:"not null"`
+	CodeChallengeMethod string    `gorm:"type:varchar(10);not null"`
+	Nonce               string    `gorm:"type:varchar(255)"`
+	ExpiresAt           time.Time `gorm:"not null;index"`
+	UsedAt              *time.Time
+	CreatedAt           time.Time `gorm:"autoCreateTime"`
+}
+
+func (AuthorizationCodeModel) TableName() string {
+	return "authorization_codes"
+}
+
+type AuthorizationCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewAuthorizationCodeRepository(db *gorm.DB) *AuthorizationCodeRepository {
+	return &AuthorizationCodeRepository{db: db}
+}
+
+func (r *AuthorizationCodeRepository) Create(ctx context.Context, code *entity.AuthorizationCode) error {
+	model := r.toModel(code)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *AuthorizationCodeRepository) FindByCodeHash(ctx context.Context, codeHash string) (*entity.AuthorizationCode, error) {
+	var model AuthorizationCodeModel
+	if err := r.db.WithContext(ctx).Where("code_hash = ?", codeHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidGrant
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *AuthorizationCodeRepository) MarkUsed(ctx context.Context, id string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&AuthorizationCodeModel{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now()).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *AuthorizationCodeRepository) toModel(e *entity.AuthorizationCode) *AuthorizationCodeModel {
+	return &AuthorizationCodeModel{
+		ID:                  e.ID,
+		CodeHash:            e.CodeHash,
+		ClientID:            e.ClientID,
+		UserID:              e.UserID,
+		RedirectURI:         e.RedirectURI,
+		Scopes:              strings.Join(e.Scopes, " "),
+		CodeChallenge:       e.CodeChallenge,
+		CodeChallengeMethod: e.CodeChallengeMethod,
+		Nonce:               e.Nonce,
+		ExpiresAt:           e.ExpiresAt,
+		UsedAt:              e.UsedAt,
+		CreatedAt:           e.CreatedAt,
+	}
+}
+
+func (r *AuthorizationCodeRepository) toEntity(m *AuthorizationCodeModel) *entity.AuthorizationCode {
+	var scopes []string
+	if m.Scopes != "" {
+		scopes = strings.Split(m.Scopes, " ")
+	}
+	return &entity.AuthorizationCode{
+		ID:                  m.ID,
+		CodeHash:            m.CodeHash,
+		ClientID:            m.ClientID,
+		UserID:              m.UserID,
+		RedirectURI:         m.RedirectURI,
+		Scopes:              scopes,
+		CodeChallenge:       m.CodeChallenge,
+		CodeChallengeMethod: m.CodeChallengeMethod,
+		Nonce:               m.Nonce,
+		ExpiresAt:           m.ExpiresAt,
+		UsedAt:              m.UsedAt,
+		CreatedAt:           m.CreatedAt,
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"auth-service/internal/domain/entity"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -57,5 +59,39 @@ func Migrate(db *gorm.DB) error {
 		&UserModel{},
 		&RefreshTokenModel{},
 		&AuditLogModel{},
+		&ClientModel{},
+		&AuthorizationCodeModel{},
+		&IdentityModel{},
+		&UserMFAModel{},
+		&MFAChallengeModel{},
+		&WebAuthnCredentialModel{},
+		&WebAuthnChallengeModel{},
+		&VerificationTokenModel{},
+		&RoleModel{},
+		&UserRoleModel{},
 	)
 }
+
+// SeedDefaultRoles creates the built-in "user" and "admin" roles if they
+// don't already exist, so a fresh database has something to assign before
+// an operator defines custom roles through the admin API.
+func SeedDefaultRoles(db *gorm.DB) error {
+	defaults := []RoleModel{
+		{
+			Name:        entity.DefaultRoleUser,
+			Permissions: "profile.read,profile.write",
+		},
+		{
+			Name:        entity.DefaultRoleAdmin,
+			Parent:      entity.DefaultRoleUser,
+			Permissions: "users.read,users.write,roles.manage,audit.export",
+		},
+	}
+
+	for _, role := range defaults {
+		if err := db.Where("name = ?", role.Name).FirstOrCreate(&role).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type IdentityModel struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index"`
+	Provider        string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_identities_provider_subject"`
+	ProviderSubject string    `gorm:"not null;uniqueIndex:idx_identities_provider_subject"`
+	Email           string    `gorm:"type:varchar(255)"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+func (IdentityModel) TableName() string {
+	return "identities"
+}
+
+type IdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewIdentityRepository(db *gorm.DB) *IdentityRepository {
+	return &IdentityRepository{db: db}
+}
+
+func (r *IdentityRepository) Create(ctx context.Context, identity *entity.Identity) error {
+	model := r.toModel(identity)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *IdentityRepository) FindByProviderSubject(ctx context.Context, provider, providerSubject string) (*entity.Identity, error) {
+	var model IdentityModel
+	if err := r.db.WithContext(ctx).
+		Where("provider = ? AND provider_subject = ?", provider, providerSubject).
+		First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrUserNotFound
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *IdentityRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Identity, error) {
+	var models []IdentityModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabaseOperation
+	}
+
+	identities := make([]*entity.Identity, 0, len(models))
+	for i := range models {
+		identities = append(identities, r.toEntity(&models[i]))
+	}
+	return identities, nil
+}
+
+func (r *IdentityRepository) DeleteByUserIDAndProvider(ctx context.Context, userID uuid.UUID, provider string) error {
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Delete(&IdentityModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *IdentityRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&IdentityModel{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, domainErr.ErrDatabaseOperation
+	}
+	return count, nil
+}
+
+func (r *IdentityRepository) toModel(e *entity.Identity) *IdentityModel {
+	return &IdentityModel{
+		ID:              e.ID,
+		UserID:          e.UserID,
+		Provider:        e.Provider,
+		ProviderSubject: e.ProviderSubject,
+		Email:           e.Email,
+		CreatedAt:       e.CreatedAt,
+	}
+}
+
+func (r *IdentityRepository) toEntity(m *IdentityModel) *entity.Identity {
+	return &entity.Identity{
+		ID:              m.ID,
+		UserID:          m.UserID,
+		Provider:        m.Provider,
+		ProviderSubject: m.ProviderSubject,
+		Email:           m.Email,
+		CreatedAt:       m.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MFAChallengeModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	TokenHash  string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"`
+	ConsumedAt *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (MFAChallengeModel) TableName() string {
+	return "mfa_challenges"
+}
+
+type MFAChallengeRepository struct {
+	db *gorm.DB
+}
+
+func NewMFAChallengeRepository(db *gorm.DB) *MFAChallengeRepository {
+	return &MFAChallengeRepository{db: db}
+}
+
+func (r *MFAChallengeRepository) Create(ctx context.Context, challenge *entity.MFAChallenge) error {
+	model := r.toModel(challenge)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *MFAChallengeRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.MFAChallenge, error) {
+	var model MFAChallengeModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidMFAToken
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *MFAChallengeRepository) MarkConsumed(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Model(&MFAChallengeModel{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now()).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *MFAChallengeRepository) DeleteExpired(ctx context.Context) error {
+	if err := r.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now()).
+		Delete(&MFAChallengeModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *MFAChallengeRepository) toModel(e *entity.MFAChallenge) *MFAChallengeModel {
+	return &MFAChallengeModel{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		TokenHash:  e.TokenHash,
+		ExpiresAt:  e.ExpiresAt,
+		ConsumedAt: e.ConsumedAt,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func (r *MFAChallengeRepository) toEntity(m *MFAChallengeModel) *entity.MFAChallenge {
+	return &entity.MFAChallenge{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		TokenHash:  m.TokenHash,
+		ExpiresAt:  m.ExpiresAt,
+		ConsumedAt: m.ConsumedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ClientModel struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ClientID          string    `gorm:"uniqueIndex;not null"`
+	ClientSecretHash  string    `gorm:"not null"`
+	Name              string    `gorm:"not null"`
+	RedirectURIs      string    `gorm:"type:text"`
+	AllowedScopes     string    `gorm:"type:text"`
+	AllowedGrantTypes string    `gorm:"type:text"`
+	IsConfidential    bool      `gorm:"default:true"`
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+	UpdatedAt         time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ClientModel) TableName() string {
+	return "oauth_clients"
+}
+
+type ClientRepository struct {
+	db *gorm.DB
+}
+
+func NewClientRepository(db *gorm.DB) *ClientRepository {
+	return &ClientRepository{db: db}
+}
+
+func (r *ClientRepository) Create(ctx context.Context, client *entity.Client) error {
+	model := r.toModel(client)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *ClientRepository) FindByClientID(ctx context.Context, clientID string) (*entity.Client, error) {
+	var model ClientModel
+	if err := r.db.WithContext(ctx).Where("client_id = ?", clientID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidClient
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *ClientRepository) toModel(e *entity.Client) *ClientModel {
+	return &ClientModel{
+		ID:                e.ID,
+		ClientID:          e.ClientID,
+		ClientSecretHash:  e.ClientSecretHash,
+		Name:              e.Name,
+		RedirectURIs:      strings.Join(e.RedirectURIs, ","),
+		AllowedScopes:     strings.Join(e.AllowedScopes, ","),
+		AllowedGrantTypes: strings.Join(e.AllowedGrantTypes, ","),
+		IsConfidential:    e.IsConfidential,
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+	}
+}
+
+func (r *ClientRepository) toEntity(m *ClientModel) *entity.Client {
+	return &entity.Client{
+		ID:                m.ID,
+		ClientID:          m.ClientID,
+		ClientSecretHash:  m.ClientSecretHash,
+		Name:              m.Name,
+		RedirectURIs:      splitNonEmpty(m.RedirectURIs),
+		AllowedScopes:     splitNonEmpty(m.AllowedScopes),
+		AllowedGrantTypes: splitNonEmpty(m.AllowedGrantTypes),
+		IsConfidential:    m.IsConfidential,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func splitNonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
@@ -13,13 +13,16 @@ import (
 )
 
 type RefreshTokenModel struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
-	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
-	TokenHash string    `gorm:"column:token;uniqueIndex;not null"`
-	ExpiresAt time.Time `gorm:"not null;index"`
-	IsRevoked bool      `gorm:"column:revoked;default:false;index"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;index"`
+	FamilyID        uuid.UUID  `gorm:"type:uuid;index"`
+	TokenHash       string     `gorm:"column:token;uniqueIndex;not null"`
+	FingerprintHash string     `gorm:"column:fingerprint"`
+	ExpiresAt       time.Time  `gorm:"not null;index"`
+	IsRevoked       bool       `gorm:"column:revoked;default:false;index"`
+	ReplacedByID    *uuid.UUID `gorm:"column:replaced_by_id;type:uuid"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime"`
 }
 
 func (RefreshTokenModel) TableName() string {
@@ -63,6 +66,21 @@ func (r *RefreshTokenRepository) RevokeByTokenHash(ctx context.Context, tokenHas
 	return nil
 }
 
+// RevokeAndReplace atomically revokes the token at tokenHash and records
+// replacedByID as its successor, so FindByTokenHash callers can use
+// entity.RefreshToken.WasReplaced to distinguish a rotated-away token
+// (reuse worth revoking the whole family over) from one revoked for some
+// other reason.
+func (r *RefreshTokenRepository) RevokeAndReplace(ctx context.Context, tokenHash string, replacedByID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("token = ? AND revoked = FALSE", tokenHash).
+		Updates(map[string]interface{}{"revoked": true, "replaced_by_id": replacedByID}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
 func (r *RefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
 	if err := r.db.WithContext(ctx).
 		Model(&RefreshTokenModel{}).
@@ -73,6 +91,18 @@ func (r *RefreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID u
 	return nil
 }
 
+// RevokeFamily revokes every token descended from the same login, used when
+// an already-rotated token is presented again (reuse/theft detection).
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("family_id = ? AND revoked = FALSE", familyID).
+		Update("revoked", true).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
 func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 	if err := r.db.WithContext(ctx).
 		Where("expires_at < ?", time.Now()).
@@ -84,24 +114,30 @@ func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
 
 func (r *RefreshTokenRepository) toModel(e *entity.RefreshToken) *RefreshTokenModel {
 	return &RefreshTokenModel{
-		ID:        e.ID,
-		UserID:    e.UserID,
-		TokenHash: e.TokenHash,
-		ExpiresAt: e.ExpiresAt,
-		IsRevoked: e.IsRevoked,
-		CreatedAt: e.CreatedAt,
-		UpdatedAt: e.UpdatedAt,
+		ID:              e.ID,
+		UserID:          e.UserID,
+		FamilyID:        e.FamilyID,
+		TokenHash:       e.TokenHash,
+		FingerprintHash: e.FingerprintHash,
+		ExpiresAt:       e.ExpiresAt,
+		IsRevoked:       e.IsRevoked,
+		ReplacedByID:    e.ReplacedByID,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
 	}
 }
 
 func (r *RefreshTokenRepository) toEntity(m *RefreshTokenModel) *entity.RefreshToken {
 	return &entity.RefreshToken{
-		ID:        m.ID,
-		UserID:    m.UserID,
-		TokenHash: m.TokenHash,
-		ExpiresAt: m.ExpiresAt,
-		IsRevoked: m.IsRevoked,
-		CreatedAt: m.CreatedAt,
-		UpdatedAt: m.UpdatedAt,
+		ID:              m.ID,
+		UserID:          m.UserID,
+		FamilyID:        m.FamilyID,
+		TokenHash:       m.TokenHash,
+		FingerprintHash: m.FingerprintHash,
+		ExpiresAt:       m.ExpiresAt,
+		IsRevoked:       m.IsRevoked,
+		ReplacedByID:    m.ReplacedByID,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
 	}
 }
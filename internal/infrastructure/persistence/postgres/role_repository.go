@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type RoleModel struct {
+	Name        string `gorm:"primaryKey"`
+	Parent      string
+	Permissions string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (RoleModel) TableName() string {
+	return "roles"
+}
+
+// UserRoleModel links a user to a granted role by name.
+type UserRoleModel struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	RoleName  string    `gorm:"primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (UserRoleModel) TableName() string {
+	return "user_roles"
+}
+
+type RoleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) *RoleRepository {
+	return &RoleRepository{db: db}
+}
+
+func (r *RoleRepository) Create(ctx context.Context, role *entity.RoleDefinition) error {
+	model := r.toModel(role)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*entity.RoleDefinition, error) {
+	var model RoleModel
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrRoleNotFound
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *RoleRepository) List(ctx context.Context) ([]*entity.RoleDefinition, error) {
+	var models []RoleModel
+	if err := r.db.WithContext(ctx).Order("name").Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabaseOperation
+	}
+
+	roles := make([]*entity.RoleDefinition, len(models))
+	for i, model := range models {
+		roles[i] = r.toEntity(&model)
+	}
+	return roles, nil
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, name string) error {
+	result := r.db.WithContext(ctx).Where("name = ?", name).Delete(&RoleModel{})
+	if result.Error != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *RoleRepository) AssignToUser(ctx context.Context, userID uuid.UUID, roleName string) error {
+	model := &UserRoleModel{UserID: userID, RoleName: roleName}
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *RoleRepository) RevokeFromUser(ctx context.Context, userID uuid.UUID, roleName string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND role_name = ?", userID, roleName).
+		Delete(&UserRoleModel{})
+	if result.Error != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *RoleRepository) ListForUser(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var models []UserRoleModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabaseOperation
+	}
+
+	names := make([]string, len(models))
+	for i, model := range models {
+		names[i] = model.RoleName
+	}
+	return names, nil
+}
+
+func (r *RoleRepository) toModel(e *entity.RoleDefinition) *RoleModel {
+	return &RoleModel{
+		Name:        e.Name,
+		Parent:      e.Parent,
+		Permissions: strings.Join(e.Permissions, ","),
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+func (r *RoleRepository) toEntity(m *RoleModel) *entity.RoleDefinition {
+	return &entity.RoleDefinition{
+		Name:        m.Name,
+		Parent:      m.Parent,
+		Permissions: splitNonEmpty(m.Permissions),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
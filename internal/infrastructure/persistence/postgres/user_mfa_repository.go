@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type UserMFAModel struct {
+	ID                 uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID             uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	SecretEncrypted    string    `gorm:"type:text;not null"`
+	Enabled            bool      `gorm:"default:false"`
+	RecoveryCodeHashes string    `gorm:"type:text"`
+	LastUsedCounter    int64     `gorm:"default:-1"`
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime"`
+}
+
+func (UserMFAModel) TableName() string {
+	return "user_mfa"
+}
+
+type UserMFARepository struct {
+	db *gorm.DB
+}
+
+func NewUserMFARepository(db *gorm.DB) *UserMFARepository {
+	return &UserMFARepository{db: db}
+}
+
+func (r *UserMFARepository) Create(ctx context.Context, mfa *entity.UserMFA) error {
+	model := r.toModel(mfa)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *UserMFARepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserMFA, error) {
+	var model UserMFAModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrUserNotFound
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *UserMFARepository) Update(ctx context.Context, mfa *entity.UserMFA) error {
+	model := r.toModel(mfa)
+	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *UserMFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&UserMFAModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *UserMFARepository) toModel(e *entity.UserMFA) *UserMFAModel {
+	return &UserMFAModel{
+		ID:                 e.ID,
+		UserID:             e.UserID,
+		SecretEncrypted:    e.SecretEncrypted,
+		Enabled:            e.Enabled,
+		RecoveryCodeHashes: strings.Join(e.RecoveryCodeHashes, ","),
+		LastUsedCounter:    e.LastUsedCounter,
+		CreatedAt:          e.CreatedAt,
+		UpdatedAt:          e.UpdatedAt,
+	}
+}
+
+func (r *UserMFARepository) toEntity(m *UserMFAModel) *entity.UserMFA {
+	return &entity.UserMFA{
+		ID:                 m.ID,
+		UserID:             m.UserID,
+		SecretEncrypted:    m.SecretEncrypted,
+		Enabled:            m.Enabled,
+		RecoveryCodeHashes: splitNonEmpty(m.RecoveryCodeHashes),
+		LastUsedCounter:    m.LastUsedCounter,
+		CreatedAt:          m.CreatedAt,
+		UpdatedAt:          m.UpdatedAt,
+	}
+}
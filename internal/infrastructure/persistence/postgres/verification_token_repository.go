@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VerificationTokenModel struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	Purpose    string    `gorm:"not null;index"`
+	TokenHash  string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null;index"`
+	ConsumedAt *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (VerificationTokenModel) TableName() string {
+	return "verification_tokens"
+}
+
+type VerificationTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewVerificationTokenRepository(db *gorm.DB) *VerificationTokenRepository {
+	return &VerificationTokenRepository{db: db}
+}
+
+func (r *VerificationTokenRepository) Create(ctx context.Context, token *entity.VerificationToken) error {
+	model := r.toModel(token)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.VerificationToken, error) {
+	var model VerificationTokenModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidToken
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+// MarkConsumed atomically claims the token: the UPDATE only matches a row
+// that's still unconsumed, so two concurrent redemptions of the same token
+// can never both succeed. The second caller gets ErrInvalidToken and must
+// not apply whatever side effect it was about to redeem the token for.
+func (r *VerificationTokenRepository) MarkConsumed(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now())
+	if result.Error != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	if result.RowsAffected == 0 {
+		return domainErr.ErrInvalidToken
+	}
+	return nil
+}
+
+// InvalidateByUserIDAndPurpose consumes every outstanding token of the given
+// purpose for a user, so requesting a fresh link retires any earlier one
+// instead of leaving multiple valid tokens redeemable at once.
+func (r *VerificationTokenRepository) InvalidateByUserIDAndPurpose(ctx context.Context, userID uuid.UUID, purpose entity.VerificationPurpose) error {
+	if err := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("user_id = ? AND purpose = ? AND consumed_at IS NULL", userID, string(purpose)).
+		Update("consumed_at", time.Now()).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) DeleteExpired(ctx context.Context) error {
+	if err := r.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now()).
+		Delete(&VerificationTokenModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) toModel(e *entity.VerificationToken) *VerificationTokenModel {
+	return &VerificationTokenModel{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		Purpose:    string(e.Purpose),
+		TokenHash:  e.TokenHash,
+		ExpiresAt:  e.ExpiresAt,
+		ConsumedAt: e.ConsumedAt,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func (r *VerificationTokenRepository) toEntity(m *VerificationTokenModel) *entity.VerificationToken {
+	return &entity.VerificationToken{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		Purpose:    entity.VerificationPurpose(m.Purpose),
+		TokenHash:  m.TokenHash,
+		ExpiresAt:  m.ExpiresAt,
+		ConsumedAt: m.ConsumedAt,
+		CreatedAt:  m.CreatedAt,
+	}
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WebAuthnChallengeModel struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Purpose     string    `gorm:"not null"`
+	SessionData []byte    `gorm:"type:bytea;not null"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+	ConsumedAt  *time.Time
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+func (WebAuthnChallengeModel) TableName() string {
+	return "webauthn_challenges"
+}
+
+type WebAuthnChallengeRepository struct {
+	db *gorm.DB
+}
+
+func NewWebAuthnChallengeRepository(db *gorm.DB) *WebAuthnChallengeRepository {
+	return &WebAuthnChallengeRepository{db: db}
+}
+
+func (r *WebAuthnChallengeRepository) Create(ctx context.Context, challenge *entity.WebAuthnChallenge) error {
+	model := r.toModel(challenge)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnChallengeRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WebAuthnChallenge, error) {
+	var model WebAuthnChallengeModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrInvalidWebAuthnChallenge
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *WebAuthnChallengeRepository) MarkConsumed(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).
+		Model(&WebAuthnChallengeModel{}).
+		Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now()).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnChallengeRepository) DeleteExpired(ctx context.Context) error {
+	if err := r.db.WithContext(ctx).
+		Where("expires_at < ?", time.Now()).
+		Delete(&WebAuthnChallengeModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnChallengeRepository) toModel(e *entity.WebAuthnChallenge) *WebAuthnChallengeModel {
+	return &WebAuthnChallengeModel{
+		ID:          e.ID,
+		UserID:      e.UserID,
+		Purpose:     string(e.Purpose),
+		SessionData: e.SessionData,
+		ExpiresAt:   e.ExpiresAt,
+		ConsumedAt:  e.ConsumedAt,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func (r *WebAuthnChallengeRepository) toEntity(m *WebAuthnChallengeModel) *entity.WebAuthnChallenge {
+	return &entity.WebAuthnChallenge{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		Purpose:     entity.WebAuthnChallengePurpose(m.Purpose),
+		SessionData: m.SessionData,
+		ExpiresAt:   m.ExpiresAt,
+		ConsumedAt:  m.ConsumedAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
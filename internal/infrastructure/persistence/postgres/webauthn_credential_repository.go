@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain/entity"
+	domainErr "auth-service/internal/domain/error"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WebAuthnCredentialModel struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index"`
+	CredentialID    string    `gorm:"uniqueIndex;not null"`
+	PublicKey       string    `gorm:"type:text;not null"`
+	AttestationType string
+	Transports      string
+	AAGUID          string
+	SignCount       uint32
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+}
+
+func (WebAuthnCredentialModel) TableName() string {
+	return "webauthn_credentials"
+}
+
+type WebAuthnCredentialRepository struct {
+	db *gorm.DB
+}
+
+func NewWebAuthnCredentialRepository(db *gorm.DB) *WebAuthnCredentialRepository {
+	return &WebAuthnCredentialRepository{db: db}
+}
+
+func (r *WebAuthnCredentialRepository) Create(ctx context.Context, credential *entity.WebAuthnCredential) error {
+	model := r.toModel(credential)
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) FindByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.WebAuthnCredential, error) {
+	var models []WebAuthnCredentialModel
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&models).Error; err != nil {
+		return nil, domainErr.ErrDatabaseOperation
+	}
+
+	credentials := make([]*entity.WebAuthnCredential, 0, len(models))
+	for _, m := range models {
+		credentials = append(credentials, r.toEntity(&m))
+	}
+	return credentials, nil
+}
+
+func (r *WebAuthnCredentialRepository) FindByCredentialID(ctx context.Context, credentialID []byte) (*entity.WebAuthnCredential, error) {
+	var model WebAuthnCredentialModel
+	if err := r.db.WithContext(ctx).Where("credential_id = ?", encodeCredentialID(credentialID)).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErr.ErrCredentialNotFound
+		}
+		return nil, domainErr.ErrDatabaseOperation
+	}
+	return r.toEntity(&model), nil
+}
+
+func (r *WebAuthnCredentialRepository) UpdateSignCount(ctx context.Context, id uuid.UUID, signCount uint32) error {
+	if err := r.db.WithContext(ctx).
+		Model(&WebAuthnCredentialModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"sign_count": signCount, "updated_at": time.Now()}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) DeleteByID(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&WebAuthnCredentialModel{}).Error; err != nil {
+		return domainErr.ErrDatabaseOperation
+	}
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) toModel(e *entity.WebAuthnCredential) *WebAuthnCredentialModel {
+	return &WebAuthnCredentialModel{
+		ID:              e.ID,
+		UserID:          e.UserID,
+		CredentialID:    encodeCredentialID(e.CredentialID),
+		PublicKey:       base64.RawURLEncoding.EncodeToString(e.PublicKey),
+		AttestationType: e.AttestationType,
+		Transports:      strings.Join(e.Transports, ","),
+		AAGUID:          base64.RawURLEncoding.EncodeToString(e.AAGUID),
+		SignCount:       e.SignCount,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+func (r *WebAuthnCredentialRepository) toEntity(m *WebAuthnCredentialModel) *entity.WebAuthnCredential {
+	credentialID, _ := base64.RawURLEncoding.DecodeString(m.CredentialID)
+	publicKey, _ := base64.RawURLEncoding.DecodeString(m.PublicKey)
+	aaguid, _ := base64.RawURLEncoding.DecodeString(m.AAGUID)
+
+	return &entity.WebAuthnCredential{
+		ID:              m.ID,
+		UserID:          m.UserID,
+		CredentialID:    credentialID,
+		PublicKey:       publicKey,
+		AttestationType: m.AttestationType,
+		Transports:      splitNonEmpty(m.Transports),
+		AAGUID:          aaguid,
+		SignCount:       m.SignCount,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func encodeCredentialID(id []byte) string {
+	return base64.RawURLEncoding.EncodeToString(id)
+}
@@ -7,12 +7,15 @@ import (
 	"errors"
 	"time"
 
+	"auth-service/internal/domain/entity"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
 type JWTService struct {
 	secret         string
 	accessTokenTTL time.Duration
+	signer         *OIDCSigner
 }
 
 func NewJWTService(secret string, accessTokenTTL time.Duration) *JWTService {
@@ -22,12 +25,136 @@ func NewJWTService(secret string, accessTokenTTL time.Duration) *JWTService {
 	}
 }
 
+// UseAsymmetricSigning switches access-token signing from HS256 to the
+// RS256 + kid key managed by signer, so access tokens verify via the same
+// JWKS endpoint as id_tokens and survive signer.RotateKey. Existing HS256
+// tokens issued before this call keep validating: ValidateAccessToken
+// picks the verification path from the token's own alg header.
+func (s *JWTService) UseAsymmetricSigning(signer *OIDCSigner) {
+	s.signer = signer
+}
+
 func (s *JWTService) GenerateAccessToken(userID string) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"exp":     time.Now().Add(s.accessTokenTTL).Unix(),
 		"iat":     time.Now().Unix(),
 	}
+	return s.sign(claims)
+}
+
+// GenerateAccessTokenWithClaims bakes a user's RBAC roles and effective
+// permissions into the token so ValidateAccessToken can authorize requests
+// without a database round trip per request.
+func (s *JWTService) GenerateAccessTokenWithClaims(userID string, roles, permissions []string) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id":     userID,
+		"roles":       roles,
+		"permissions": permissions,
+		"exp":         time.Now().Add(s.accessTokenTTL).Unix(),
+		"iat":         time.Now().Unix(),
+	}
+	return s.sign(claims)
+}
+
+// sensitiveOpPurpose marks a token minted by Reauthenticate, so
+// ValidateSensitiveOpToken can tell it apart from an ordinary access token
+// signed with the same key.
+const sensitiveOpPurpose = "sensitive_op"
+
+// GenerateSensitiveOpToken mints a short-lived token proving the caller
+// just reauthenticated, for operations like ChangePassword that require a
+// fresher proof of identity than a long-lived access token gives.
+func (s *JWTService) GenerateSensitiveOpToken(userID string, ttl time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"purpose": sensitiveOpPurpose,
+		"exp":     time.Now().Add(ttl).Unix(),
+		"iat":     time.Now().Unix(),
+	}
+	return s.sign(claims)
+}
+
+// ValidateSensitiveOpToken verifies a token minted by GenerateSensitiveOpToken
+// and returns the user ID it was issued for.
+func (s *JWTService) ValidateSensitiveOpToken(tokenString string) (string, error) {
+	claims, err := s.parseClaims(tokenString)
+	if err != nil {
+		return "", err
+	}
+
+	if purpose, _ := claims["purpose"].(string); purpose != sensitiveOpPurpose {
+		return "", errors.New("not a sensitive-op token")
+	}
+
+	if expVal, ok := claims["exp"].(float64); ok {
+		if time.Unix(int64(expVal), 0).Before(time.Now()) {
+			return "", errors.New("token expired")
+		}
+	}
+
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return "", errors.New("user_id missing in token")
+	}
+	return userID, nil
+}
+
+// loginCompletionPurpose marks a ticket minted by Login after a successful
+// password check but before a required second factor is satisfied, so
+// ValidateLoginCompletionTicket can tell it apart from an ordinary access
+// or sensitive-op token signed with the same key.
+const loginCompletionPurpose = "login_completion"
+
+// GenerateLoginCompletionTicket mints a short-lived ticket proving userID
+// already passed the password step of login ("amr":["pwd"]) and still has
+// a pending second factor ("mfa_pending":true), so a WebAuthn (or other)
+// MFA completion ceremony can be bound to that same login instead of being
+// reachable as a standalone, password-less authentication.
+func (s *JWTService) GenerateLoginCompletionTicket(userID string, ttl time.Duration) (string, error) {
+	claims := jwt.MapClaims{
+		"user_id":     userID,
+		"purpose":     loginCompletionPurpose,
+		"amr":         []string{"pwd"},
+		"mfa_pending": true,
+		"exp":         time.Now().Add(ttl).Unix(),
+		"iat":         time.Now().Unix(),
+	}
+	return s.sign(claims)
+}
+
+// ValidateLoginCompletionTicket verifies a ticket minted by
+// GenerateLoginCompletionTicket and returns the user ID it was issued for.
+func (s *JWTService) ValidateLoginCompletionTicket(ticket string) (string, error) {
+	claims, err := s.parseClaims(ticket)
+	if err != nil {
+		return "", err
+	}
+
+	if purpose, _ := claims["purpose"].(string); purpose != loginCompletionPurpose {
+		return "", errors.New("not a login completion ticket")
+	}
+	if pending, _ := claims["mfa_pending"].(bool); !pending {
+		return "", errors.New("login completion ticket missing mfa_pending")
+	}
+
+	if expVal, ok := claims["exp"].(float64); ok {
+		if time.Unix(int64(expVal), 0).Before(time.Now()) {
+			return "", errors.New("token expired")
+		}
+	}
+
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return "", errors.New("user_id missing in token")
+	}
+	return userID, nil
+}
+
+func (s *JWTService) sign(claims jwt.MapClaims) (string, error) {
+	if s.signer != nil {
+		return s.signer.Sign(claims)
+	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(s.secret))
@@ -49,37 +176,87 @@ func (s *JWTService) HashToken(plain string) string {
 	return base64.RawURLEncoding.EncodeToString(sum[:])
 }
 
-func (s *JWTService) ValidateAccessToken(tokenString string) (string, error) {
+func (s *JWTService) ValidateAccessToken(tokenString string) (*entity.Principal, error) {
+	claims, err := s.parseClaims(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if expVal, ok := claims["exp"].(float64); ok {
+		if time.Unix(int64(expVal), 0).Before(time.Now()) {
+			return nil, errors.New("token expired")
+		}
+	}
+
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return nil, errors.New("user_id missing in token")
+	}
+
+	return &entity.Principal{
+		UserID:      userID,
+		Roles:       stringClaimSlice(claims["roles"]),
+		Permissions: stringClaimSlice(claims["permissions"]),
+	}, nil
+}
+
+// parseClaims verifies tokenString against whichever scheme it was signed
+// with: RS256 via the attached OIDCSigner's kid-keyed JWKS, or HS256 via
+// the shared secret. Accepting both lets access tokens issued before a
+// UseAsymmetricSigning migration keep validating until they expire.
+func (s *JWTService) parseClaims(tokenString string) (jwt.MapClaims, error) {
+	if s.signer != nil {
+		if alg, ok := tokenAlg(tokenString); ok && alg == "RS256" {
+			return s.signer.Verify(tokenString)
+		}
+	}
+
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
 			return nil, errors.New("unexpected signing method")
 		}
 		return []byte(s.secret), nil
 	}, jwt.WithValidMethods([]string{"HS256"}))
-
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
 	if !token.Valid {
-		return "", errors.New("invalid token")
+		return nil, errors.New("invalid token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", errors.New("invalid claims")
+		return nil, errors.New("invalid claims")
 	}
+	return claims, nil
+}
 
-	if expVal, ok := claims["exp"].(float64); ok {
-		if time.Unix(int64(expVal), 0).Before(time.Now()) {
-			return "", errors.New("token expired")
-		}
+// tokenAlg reads the "alg" header of a JWT without verifying its signature,
+// just enough to route to the right verification path above.
+func tokenAlg(tokenString string) (string, bool) {
+	parser := jwt.NewParser()
+	token, _, err := parser.ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return "", false
 	}
+	alg, ok := token.Header["alg"].(string)
+	return alg, ok
+}
 
-	userID, ok := claims["user_id"].(string)
-	if !ok || userID == "" {
-		return "", errors.New("user_id missing in token")
+// stringClaimSlice converts a JWT claim decoded as []interface{} (the JSON
+// array shape jwt.MapClaims produces) into []string, skipping anything that
+// isn't a string.
+func stringClaimSlice(claim interface{}) []string {
+	raw, ok := claim.([]interface{})
+	if !ok {
+		return nil
 	}
 
-	return userID, nil
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
 }
@@ -0,0 +1,216 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// OIDCSigner signs id_tokens (and, when plugged into JWTService via
+// UseAsymmetricSigning, access tokens too) with RS256 and publishes the
+// corresponding public key(s) as a JWKS document, per OpenID Connect Core +
+// RFC 7517. RotateKey lets the signing key be rotated on a schedule without
+// invalidating tokens signed just before the rotation: retired keys stay
+// published in JWKS for grace before being dropped.
+type OIDCSigner struct {
+	mu      sync.RWMutex
+	issuer  string
+	grace   time.Duration
+	current *oidcKey
+	retired []*oidcKey
+}
+
+type oidcKey struct {
+	keyID      string
+	privateKey *rsa.PrivateKey
+	createdAt  time.Time
+}
+
+// NewOIDCSigner builds a signer from a static, pre-provisioned RSA private
+// key (PEM, PKCS1 or PKCS8). grace bounds how long a key stays in JWKS
+// after RotateKey retires it.
+func NewOIDCSigner(privateKeyPEM, issuer, keyID string, grace time.Duration) (*OIDCSigner, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("invalid RSA private key PEM")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsed, errPKCS8 := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if errPKCS8 != nil {
+			return nil, errors.New("unsupported RSA private key format")
+		}
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("private key is not RSA")
+		}
+		key = rsaKey
+	}
+
+	if keyID == "" {
+		keyID = "default"
+	}
+
+	return &OIDCSigner{
+		issuer:  issuer,
+		grace:   grace,
+		current: &oidcKey{keyID: keyID, privateKey: key, createdAt: time.Now()},
+	}, nil
+}
+
+// RotateKey generates a fresh 2048-bit RSA key and makes it current,
+// retiring the previous one into the grace-period set instead of
+// discarding it, so tokens it already signed keep verifying until grace
+// elapses.
+func (s *OIDCSigner) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.current != nil {
+		s.retired = append(s.retired, s.current)
+	}
+	s.current = &oidcKey{keyID: newKeyID(), privateKey: key, createdAt: time.Now()}
+
+	fresh := s.retired[:0]
+	for _, k := range s.retired {
+		if time.Since(k.createdAt) < s.grace {
+			fresh = append(fresh, k)
+		}
+	}
+	s.retired = fresh
+
+	return nil
+}
+
+func newKeyID() string {
+	raw := make([]byte, 8)
+	_, _ = rand.Read(raw)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (s *OIDCSigner) SignIDToken(subject, audience, nonce string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss": s.issuer,
+		"sub": subject,
+		"aud": audience,
+		"exp": now.Add(ttl).Unix(),
+		"iat": now.Unix(),
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	return s.Sign(claims)
+}
+
+// Sign signs arbitrary claims with the current key, RS256, carrying its kid
+// in the header so JWKS consumers (and Verify, after a rotation) know
+// which key to check the signature against.
+func (s *OIDCSigner) Sign(claims jwt.MapClaims) (string, error) {
+	s.mu.RLock()
+	current := s.current
+	s.mu.RUnlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = current.keyID
+	return token.SignedString(current.privateKey)
+}
+
+// Verify parses tokenString and selects the verification key by its kid
+// header, checking both the current key and any still within their grace
+// period.
+func (s *OIDCSigner) Verify(tokenString string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		kid, _ := token.Header["kid"].(string)
+		key := s.publicKeyForKid(kid)
+		if key == nil {
+			return nil, errors.New("unknown signing key")
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{"RS256"}))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid claims")
+	}
+	return claims, nil
+}
+
+func (s *OIDCSigner) publicKeyForKid(kid string) *rsa.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.current.keyID == kid {
+		return &s.current.privateKey.PublicKey
+	}
+	for _, k := range s.retired {
+		if k.keyID == kid {
+			return &k.privateKey.PublicKey
+		}
+	}
+	return nil
+}
+
+// JWKS returns the public key set in the format expected at
+// /.well-known/jwks.json: the current signing key plus any still within
+// their grace period.
+func (s *OIDCSigner) JWKS() map[string]interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]map[string]interface{}, 0, len(s.retired)+1)
+	keys = append(keys, jwkFor(s.current))
+	for _, k := range s.retired {
+		keys = append(keys, jwkFor(k))
+	}
+	return map[string]interface{}{"keys": keys}
+}
+
+func jwkFor(k *oidcKey) map[string]interface{} {
+	pub := k.privateKey.PublicKey
+	return map[string]interface{}{
+		"kty": "RSA",
+		"use": "sig",
+		"alg": "RS256",
+		"kid": k.keyID,
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big32(pub.E)),
+	}
+}
+
+func big32(e int) []byte {
+	b := make([]byte, 0, 4)
+	for shift := 24; shift >= 0; shift -= 8 {
+		v := byte(e >> uint(shift))
+		if len(b) == 0 && v == 0 && shift != 0 {
+			continue
+		}
+		b = append(b, v)
+	}
+	if len(b) == 0 {
+		b = append(b, 0)
+	}
+	return b
+}
@@ -0,0 +1,16 @@
+package security
+
+import qrcode "github.com/skip2/go-qrcode"
+
+// QRCodeService renders an otpauth:// URI as a PNG so clients that can't
+// type a TOTP secret by hand can scan it into an authenticator app instead.
+type QRCodeService struct{}
+
+func NewQRCodeService() *QRCodeService {
+	return &QRCodeService{}
+}
+
+// EncodePNG renders content as a square QR code of size x size pixels.
+func (s *QRCodeService) EncodePNG(content string, size int) ([]byte, error) {
+	return qrcode.Encode(content, qrcode.Medium, size)
+}
@@ -0,0 +1,170 @@
+package security
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"auth-service/internal/domain/entity"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+)
+
+// WebAuthnCredentialData is what a verified registration or login ceremony
+// reveals about the authenticator credential involved, in a form usecase
+// can turn into an entity.WebAuthnCredential without depending on the
+// go-webauthn types directly.
+type WebAuthnCredentialData struct {
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	Transports      []string
+	AAGUID          []byte
+	SignCount       uint32
+}
+
+// WebAuthnService runs registration and login ceremonies per the W3C
+// WebAuthn spec on top of github.com/go-webauthn/webauthn. The sessionData
+// it returns from a Begin call must be round-tripped back into the matching
+// Finish call unchanged (see WebAuthnChallengeRepository).
+type WebAuthnService struct {
+	webAuthn *webauthn.WebAuthn
+}
+
+func NewWebAuthnService(rpID, rpDisplayName string, rpOrigins []string) (*WebAuthnService, error) {
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          rpID,
+		RPDisplayName: rpDisplayName,
+		RPOrigins:     rpOrigins,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WebAuthnService{webAuthn: w}, nil
+}
+
+func (s *WebAuthnService) BeginRegistration(userID uuid.UUID, email string, existing []*entity.WebAuthnCredential) (optionsJSON, sessionData []byte, err error) {
+	options, session, err := s.webAuthn.BeginRegistration(newWebAuthnUser(userID, email, existing))
+	if err != nil {
+		return nil, nil, err
+	}
+	return marshalPair(options, session)
+}
+
+func (s *WebAuthnService) FinishRegistration(existing []*entity.WebAuthnCredential, userID uuid.UUID, email string, sessionData, rawResponse []byte) (credentialID, publicKey []byte, attestationType string, transports []string, aaguid []byte, signCount uint32, err error) {
+	var session webauthn.SessionData
+	if err = json.Unmarshal(sessionData, &session); err != nil {
+		return nil, nil, "", nil, nil, 0, err
+	}
+
+	cred, err := s.webAuthn.FinishRegistration(newWebAuthnUser(userID, email, existing), session, fakeRequest(rawResponse))
+	if err != nil {
+		return nil, nil, "", nil, nil, 0, err
+	}
+	data := toCredentialData(cred)
+	return data.CredentialID, data.PublicKey, data.AttestationType, data.Transports, data.AAGUID, data.SignCount, nil
+}
+
+func (s *WebAuthnService) BeginLogin(userID uuid.UUID, email string, existing []*entity.WebAuthnCredential) (optionsJSON, sessionData []byte, err error) {
+	options, session, err := s.webAuthn.BeginLogin(newWebAuthnUser(userID, email, existing))
+	if err != nil {
+		return nil, nil, err
+	}
+	return marshalPair(options, session)
+}
+
+func (s *WebAuthnService) FinishLogin(existing []*entity.WebAuthnCredential, userID uuid.UUID, email string, sessionData, rawResponse []byte) (credentialID []byte, newSignCount uint32, err error) {
+	var session webauthn.SessionData
+	if err := json.Unmarshal(sessionData, &session); err != nil {
+		return nil, 0, err
+	}
+
+	cred, err := s.webAuthn.FinishLogin(newWebAuthnUser(userID, email, existing), session, fakeRequest(rawResponse))
+	if err != nil {
+		return nil, 0, err
+	}
+	return cred.ID, cred.Authenticator.SignCount, nil
+}
+
+func marshalPair(options interface{}, session *webauthn.SessionData) (optionsJSON, sessionData []byte, err error) {
+	optionsJSON, err = json.Marshal(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	sessionData, err = json.Marshal(session)
+	if err != nil {
+		return nil, nil, err
+	}
+	return optionsJSON, sessionData, nil
+}
+
+// fakeRequest lets rawResponse - the raw JSON body the frontend posted back
+// from navigator.credentials.create()/.get() - be parsed by go-webauthn's
+// http.Request-shaped Finish* methods without this service owning an actual
+// HTTP handler.
+func fakeRequest(rawResponse []byte) *http.Request {
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(rawResponse))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func toCredentialData(cred *webauthn.Credential) *WebAuthnCredentialData {
+	transports := make([]string, 0, len(cred.Transport))
+	for _, t := range cred.Transport {
+		transports = append(transports, string(t))
+	}
+	return &WebAuthnCredentialData{
+		CredentialID:    cred.ID,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		Transports:      transports,
+		AAGUID:          cred.Authenticator.AAGUID,
+		SignCount:       cred.Authenticator.SignCount,
+	}
+}
+
+func newWebAuthnUser(userID uuid.UUID, email string, existing []*entity.WebAuthnCredential) webauthn.User {
+	return &webAuthnUserAdapter{userID: userID, email: email, credentials: toWebAuthnCredentials(existing)}
+}
+
+// webAuthnUserAdapter adapts a domain user plus their registered
+// credentials to the webauthn.User interface the library's ceremonies
+// require.
+type webAuthnUserAdapter struct {
+	userID      uuid.UUID
+	email       string
+	credentials []webauthn.Credential
+}
+
+func (u *webAuthnUserAdapter) WebAuthnID() []byte                         { return u.userID[:] }
+func (u *webAuthnUserAdapter) WebAuthnName() string                       { return u.email }
+func (u *webAuthnUserAdapter) WebAuthnDisplayName() string                { return u.email }
+func (u *webAuthnUserAdapter) WebAuthnCredentials() []webauthn.Credential { return u.credentials }
+
+func toWebAuthnCredentials(existing []*entity.WebAuthnCredential) []webauthn.Credential {
+	credentials := make([]webauthn.Credential, 0, len(existing))
+	for _, c := range existing {
+		credentials = append(credentials, webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationType,
+			Transport:       transportsFrom(c.Transports),
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: c.SignCount,
+			},
+		})
+	}
+	return credentials
+}
+
+func transportsFrom(transports []string) []protocol.AuthenticatorTransport {
+	converted := make([]protocol.AuthenticatorTransport, 0, len(transports))
+	for _, t := range transports {
+		converted = append(converted, protocol.AuthenticatorTransport(t))
+	}
+	return converted
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"auth-service/internal/repository"
+)
+
+// RequireAdmin gates a handler behind AuthMiddleware's "userID" plus a
+// role check, so routes that affect every user's tokens (like rotating the
+// signing key) aren't reachable by an ordinary authenticated user.
+func RequireAdmin(userRepo *repository.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.GetString("userID"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing authenticated session"})
+			return
+		}
+
+		user, err := userRepo.FindUserByID(userID)
+		if err != nil || user.Role != "admin" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "admin privileges required"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -9,9 +9,13 @@ import (
 	"auth-service/utils"
 )
 
-// AuthMiddleware validates JWT from Authorization: Bearer <token>
-// On success, sets "userID" into the Gin context and calls next.
-func AuthMiddleware(secret string) gin.HandlerFunc {
+// AuthMiddleware validates JWT from Authorization: Bearer <token>, verifying
+// the signature against whichever key the token's kid header names (the
+// current signing key or one still in its grace period), and, if denylist
+// is non-nil, rejecting tokens revoked mid-TTL by /logout or /logout-all.
+// On success, sets "userID", "jti" and "tokenExpiresAt" into the Gin context
+// and calls next.
+func AuthMiddleware(keys *utils.KeyManager, denylist *utils.TokenDenylist) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -25,13 +29,20 @@ func AuthMiddleware(secret string) gin.HandlerFunc {
 			return
 		}
 
-		userID, err := utils.ParseToken(tokenStr, secret)
-		if err != nil || userID == "" {
+		claims, err := keys.VerifyToken(tokenStr)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
 			return
 		}
 
-		c.Set("userID", userID)
+		if denylist != nil && denylist.IsRevoked(c.Request.Context(), claims.JTI) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "token has been revoked"})
+			return
+		}
+
+		c.Set("userID", claims.UserID)
+		c.Set("jti", claims.JTI)
+		c.Set("tokenExpiresAt", claims.ExpiresAt)
 		c.Next()
 	}
 }
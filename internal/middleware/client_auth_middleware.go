@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainSecurity "auth-service/internal/domain/security"
+	"auth-service/internal/repository"
+)
+
+// RequireClientAuth gates a handler behind HTTP Basic auth checked against
+// the oauth_clients table, so only registered downstream services can call
+// the token introspection/revocation endpoints.
+func RequireClientAuth(clientRepo *repository.OAuthClientRepository, hasher domainSecurity.PasswordHasher) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		clientID, clientSecret, ok := c.Request.BasicAuth()
+		if !ok {
+			c.Header("WWW-Authenticate", `Basic realm="oauth"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "client authentication required"})
+			return
+		}
+
+		client, err := clientRepo.FindByClientID(clientID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid client credentials"})
+			return
+		}
+
+		valid, err := hasher.Verify(client.ClientSecretHash, clientSecret)
+		if err != nil || !valid {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid client credentials"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OAuthClient is a downstream service allowed to call the token
+// introspection and revocation endpoints. It authenticates with HTTP
+// Basic, presenting ClientID and the plaintext secret that hashes to
+// ClientSecretHash.
+type OAuthClient struct {
+	ID               uuid.UUID `gorm:"type:uuid;primaryKey" json:"id"`
+	ClientID         string    `gorm:"uniqueIndex;not null" json:"client_id"`
+	ClientSecretHash string    `gorm:"not null" json:"-"`
+	Name             string    `gorm:"not null" json:"name"`
+	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// BeforeCreate sets a default UUID (v7) before creating a new OAuthClient record
+func (c *OAuthClient) BeforeCreate(tx *gorm.DB) (err error) {
+	c.ID = uuid.Must(uuid.NewV7())
+	return
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RefreshToken is one issued refresh token, stored as a hash. FamilyID
+// links every token produced by rotating the same original login, so a
+// reused (already-rotated-away) token can revoke the whole chain instead
+// of just itself. ExpiresAt slides forward on each use (up to HardExpiresAt,
+// the family's fixed ceiling from initial login) so an idle session still
+// expires on schedule while an active one doesn't get logged out from
+// under it.
+type RefreshToken struct {
+	ID            uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID        uuid.UUID  `gorm:"type:uuid;index;not null" json:"user_id"`
+	FamilyID      uuid.UUID  `gorm:"type:uuid;index;not null" json:"-"`
+	Token         string     `gorm:"uniqueIndex;not null" json:"-"`
+	Revoked       bool       `gorm:"default:false" json:"-"`
+	ReplacedByID  *uuid.UUID `gorm:"type:uuid" json:"-"`
+	ExpiresAt     time.Time  `json:"-"`
+	HardExpiresAt time.Time  `json:"-"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// BeforeCreate sets a default UUID (v7) and, for the first token in a
+// family, a matching FamilyID.
+func (rt *RefreshToken) BeforeCreate(tx *gorm.DB) (err error) {
+	rt.ID = uuid.Must(uuid.NewV7())
+	if rt.FamilyID == uuid.Nil {
+		rt.FamilyID = rt.ID
+	}
+	return
+}
+
+// WasReplaced reports whether this token was revoked specifically because
+// it was rotated into a successor, as opposed to being revoked by logout
+// or an admin action. Only a replaced-and-reused token is evidence of
+// theft; an ordinary revoked token replayed later is just stale.
+func (rt *RefreshToken) WasReplaced() bool {
+	return rt.Revoked && rt.ReplacedByID != nil
+}
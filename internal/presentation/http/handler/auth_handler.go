@@ -62,6 +62,43 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
+	if result.MFARequired {
+		response.Success(c, http.StatusOK, gin.H{
+			"mfa_required": true,
+			"mfa_token":    result.MFAToken,
+			"mfa_method":   result.MFAMethod,
+		})
+		return
+	}
+
+	h.setRefreshCookie(c, result.RefreshToken)
+
+	response.Success(c, http.StatusOK, gin.H{
+		"access_token": result.AccessToken,
+		"token_type":   result.TokenType,
+		"expires_in":   result.ExpiresIn,
+		"user":         result.User,
+	})
+}
+
+// VerifyMFA redeems the mfa_token from Login along with a TOTP or recovery
+// code, completing the login that Login left pending.
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var req dto.VerifyMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	ipAddress := c.ClientIP()
+	userAgent := c.GetHeader("User-Agent")
+
+	result, err := h.authUseCase.VerifyTOTP(c.Request.Context(), req, ipAddress, userAgent)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
 	h.setRefreshCookie(c, result.RefreshToken)
 
 	response.Success(c, http.StatusOK, gin.H{
@@ -72,6 +109,230 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	})
 }
 
+// EnrollMFA generates a TOTP secret and recovery codes for the current user.
+// MFA only takes effect on Login after ConfirmMFA verifies a code.
+func (h *AuthHandler) EnrollMFA(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	result, err := h.authUseCase.EnrollTOTP(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+func (h *AuthHandler) ConfirmMFA(c *gin.Context) {
+	var req dto.ConfirmTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	userID := c.GetString("userID")
+
+	if err := h.authUseCase.ConfirmTOTP(c.Request.Context(), userID, req.Code); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "MFA enabled successfully",
+	})
+}
+
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	var req dto.DisableTOTPRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	userID := c.GetString("userID")
+
+	if err := h.authUseCase.DisableTOTP(c.Request.Context(), userID, req.Password, req.ReauthToken); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "MFA disabled successfully",
+	})
+}
+
+// BeginWebAuthnRegistration starts a ceremony to register a new passkey for
+// the current user, returning the options blob navigator.credentials.create
+// needs alongside a challenge ID the frontend echoes back to FinishWebAuthnRegistration.
+func (h *AuthHandler) BeginWebAuthnRegistration(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	result, err := h.authUseCase.BeginWebAuthnRegistration(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+func (h *AuthHandler) FinishWebAuthnRegistration(c *gin.Context) {
+	var req dto.FinishWebAuthnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	userID := c.GetString("userID")
+
+	result, err := h.authUseCase.FinishWebAuthnRegistration(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, result)
+}
+
+// BeginWebAuthnLogin starts the WebAuthn ceremony completing a password
+// login's "webauthn" MFA step, identified by the login completion ticket
+// Login returned.
+func (h *AuthHandler) BeginWebAuthnLogin(c *gin.Context) {
+	var req dto.BeginWebAuthnLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	result, err := h.authUseCase.BeginWebAuthnLogin(c.Request.Context(), req.Ticket)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+func (h *AuthHandler) FinishWebAuthnLogin(c *gin.Context) {
+	var req dto.FinishWebAuthnLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	ipAddress := c.ClientIP()
+	userAgent := c.GetHeader("User-Agent")
+
+	result, err := h.authUseCase.FinishWebAuthnLogin(c.Request.Context(), req, ipAddress, userAgent)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.setRefreshCookie(c, result.RefreshToken)
+
+	response.Success(c, http.StatusOK, gin.H{
+		"access_token": result.AccessToken,
+		"token_type":   result.TokenType,
+		"expires_in":   result.ExpiresIn,
+		"user":         result.User,
+	})
+}
+
+// Reauthenticate confirms a fresh password (and TOTP code, if enabled) and
+// returns a short-lived reauth_token that ChangePassword and DisableMFA
+// require, so a hijacked but still-valid session can't perform those
+// sensitive operations without reproving the password.
+func (h *AuthHandler) Reauthenticate(c *gin.Context) {
+	var req dto.ReauthenticateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	userID := c.GetString("userID")
+
+	result, err := h.authUseCase.Reauthenticate(c.Request.Context(), userID, req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+// ResendVerification re-sends the email-verification link for an unverified
+// account. It always reports success, whether or not the address exists.
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	var req dto.ResendVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.authUseCase.ResendVerificationEmail(c.Request.Context(), req.Email); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "If the account exists and is unverified, a verification email has been sent",
+	})
+}
+
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.authUseCase.VerifyEmail(c.Request.Context(), req.Token); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "Email verified successfully",
+	})
+}
+
+// ForgotPassword always reports success, whether or not the address exists,
+// so the endpoint can't be used to enumerate accounts.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.authUseCase.ForgotPassword(c.Request.Context(), req.Email); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "If the account exists, a password reset email has been sent",
+	})
+}
+
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.authUseCase.ResetPassword(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"message": "Password reset successfully",
+	})
+}
+
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	refreshToken, err := c.Cookie(h.config.Cookie.RefreshCookieName)
 	if err != nil || refreshToken == "" {
@@ -79,7 +340,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	result, err := h.authUseCase.RefreshToken(c.Request.Context(), refreshToken)
+	result, err := h.authUseCase.RefreshToken(c.Request.Context(), refreshToken, c.ClientIP(), c.GetHeader("User-Agent"))
 	if err != nil {
 		h.clearRefreshCookie(c)
 		h.handleError(c, err)
@@ -205,6 +466,20 @@ func (h *AuthHandler) handleError(c *gin.Context, err error) {
 		response.BadRequest(c, "Password is too weak. Must be at least 8 characters with uppercase, lowercase, number, and special character")
 	case domainErr.ErrInvalidPassword:
 		response.BadRequest(c, "Invalid password")
+	case domainErr.ErrEmailAlreadyVerified:
+		response.Conflict(c, "Email is already verified")
+	case domainErr.ErrMFAAlreadyEnabled:
+		response.Conflict(c, "MFA is already enabled")
+	case domainErr.ErrMFANotEnabled:
+		response.BadRequest(c, "MFA is not enabled")
+	case domainErr.ErrInvalidMFACode, domainErr.ErrInvalidMFAToken:
+		response.Unauthorized(c, "Invalid or expired MFA code")
+	case domainErr.ErrInvalidWebAuthnChallenge:
+		response.Unauthorized(c, "Invalid or expired webauthn challenge")
+	case domainErr.ErrNoWebAuthnCredentials, domainErr.ErrCredentialNotFound:
+		response.BadRequest(c, "No registered webauthn credentials")
+	case domainErr.ErrReauthenticationRequired:
+		response.Forbidden(c, "This operation requires a fresh reauthentication")
 	default:
 		response.InternalServerError(c, "An error occurred. Please try again")
 	}
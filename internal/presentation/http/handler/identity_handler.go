@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+
+	"auth-service/internal/application/usecase"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/infrastructure/config"
+	"auth-service/internal/infrastructure/logger"
+	"auth-service/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+type IdentityHandler struct {
+	identityUseCase *usecase.IdentityUseCase
+	config          *config.Config
+	logger          *logger.Logger
+}
+
+func NewIdentityHandler(identityUseCase *usecase.IdentityUseCase, config *config.Config, logger *logger.Logger) *IdentityHandler {
+	return &IdentityHandler{
+		identityUseCase: identityUseCase,
+		config:          config,
+		logger:          logger,
+	}
+}
+
+func (h *IdentityHandler) Login(c *gin.Context) {
+	provider := c.Param("provider")
+
+	authURL, err := h.identityUseCase.AuthURL(provider)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+func (h *IdentityHandler) Callback(c *gin.Context) {
+	provider := c.Param("provider")
+	code := c.Query("code")
+	state := c.Query("state")
+
+	result, err := h.identityUseCase.Callback(c.Request.Context(), provider, code, state, c.ClientIP(), c.GetHeader("User-Agent"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{
+		"access_token": result.AccessToken,
+		"token_type":   result.TokenType,
+		"expires_in":   result.ExpiresIn,
+		"user":         result.User,
+	})
+}
+
+func (h *IdentityHandler) LinkIdentity(c *gin.Context) {
+	provider := c.Param("provider")
+	userID := c.GetString("userID")
+
+	var req struct {
+		Code         string `json:"code" binding:"required"`
+		CodeVerifier string `json:"code_verifier"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.identityUseCase.LinkIdentity(c.Request.Context(), userID, provider, req.Code, req.CodeVerifier); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Identity linked successfully"})
+}
+
+func (h *IdentityHandler) UnlinkIdentity(c *gin.Context) {
+	provider := c.Param("provider")
+	userID := c.GetString("userID")
+
+	if err := h.identityUseCase.UnlinkIdentity(c.Request.Context(), userID, provider); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *IdentityHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error("Identity request failed", zap.Error(err))
+
+	switch err {
+	case domainErr.ErrProviderNotSupported:
+		response.NotFound(c, "Identity provider not supported")
+	case domainErr.ErrInvalidState, domainErr.ErrInvalidGrant:
+		response.Unauthorized(c, "Invalid or expired login attempt")
+	case domainErr.ErrIdentityAlreadyLinked:
+		response.Conflict(c, "Identity already linked to an account")
+	case domainErr.ErrLastCredential:
+		response.BadRequest(c, "Cannot remove the last sign-in method")
+	default:
+		response.InternalServerError(c, "An error occurred. Please try again")
+	}
+}
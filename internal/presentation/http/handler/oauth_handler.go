@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/application/usecase"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/infrastructure/config"
+	"auth-service/internal/infrastructure/logger"
+	"auth-service/internal/infrastructure/security"
+	"auth-service/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type OAuthHandler struct {
+	oauthUseCase *usecase.OAuthUseCase
+	signer       *security.OIDCSigner
+	config       *config.Config
+	logger       *logger.Logger
+}
+
+func NewOAuthHandler(oauthUseCase *usecase.OAuthUseCase, signer *security.OIDCSigner, config *config.Config, logger *logger.Logger) *OAuthHandler {
+	return &OAuthHandler{
+		oauthUseCase: oauthUseCase,
+		signer:       signer,
+		config:       config,
+		logger:       logger,
+	}
+}
+
+// Authorize requires the caller to already be authenticated (AuthMiddleware)
+// and issues a single-use authorization code bound to the PKCE challenge.
+func (h *OAuthHandler) Authorize(c *gin.Context) {
+	var req dto.AuthorizeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "Invalid authorize request")
+		return
+	}
+
+	userID, err := uuid.Parse(c.GetString("userID"))
+	if err != nil {
+		response.Unauthorized(c, "Missing authenticated session")
+		return
+	}
+
+	result, err := h.oauthUseCase.Authorize(c.Request.Context(), req, userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	redirectURL := result.RedirectURI + "?code=" + result.Code
+	if result.State != "" {
+		redirectURL += "&state=" + result.State
+	}
+	c.Redirect(http.StatusFound, redirectURL)
+}
+
+func (h *OAuthHandler) Token(c *gin.Context) {
+	var req dto.TokenRequest
+	if err := c.ShouldBind(&req); err != nil {
+		response.BadRequest(c, "Invalid token request")
+		return
+	}
+
+	result, err := h.oauthUseCase.Token(c.Request.Context(), req)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+func (h *OAuthHandler) UserInfo(c *gin.Context) {
+	userID := c.GetString("userID")
+
+	result, err := h.oauthUseCase.UserInfo(c.Request.Context(), userID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, result)
+}
+
+func (h *OAuthHandler) Introspect(c *gin.Context) {
+	token := c.PostForm("token")
+	clientID := c.PostForm("client_id")
+	clientSecret := c.PostForm("client_secret")
+	if basicID, basicSecret, ok := c.Request.BasicAuth(); ok {
+		clientID, clientSecret = basicID, basicSecret
+	}
+
+	result, err := h.oauthUseCase.Introspect(c.Request.Context(), token, clientID, clientSecret)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (h *OAuthHandler) Revoke(c *gin.Context) {
+	// Revocation of opaque refresh tokens is handled via AuthUseCase.Logout;
+	// this endpoint exists to satisfy RFC 7009 discovery for OIDC clients.
+	c.Status(http.StatusOK)
+}
+
+func (h *OAuthHandler) Discovery(c *gin.Context) {
+	issuer := h.config.OIDC.Issuer
+	c.JSON(http.StatusOK, gin.H{
+		"issuer":                                issuer,
+		"authorization_endpoint":                issuer + "/oauth2/authorize",
+		"token_endpoint":                         issuer + "/oauth2/token",
+		"userinfo_endpoint":                      issuer + "/oauth2/userinfo",
+		"revocation_endpoint":                    issuer + "/oauth2/revoke",
+		"introspection_endpoint":                 issuer + "/oauth2/introspect",
+		"jwks_uri":                               issuer + "/.well-known/jwks.json",
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code", "refresh_token"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"subject_types_supported":               []string{"public"},
+	})
+}
+
+func (h *OAuthHandler) JWKS(c *gin.Context) {
+	c.JSON(http.StatusOK, h.signer.JWKS())
+}
+
+func (h *OAuthHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error("OAuth2 request failed", zap.Error(err))
+
+	switch err {
+	case domainErr.ErrInvalidClient:
+		response.Unauthorized(c, "Invalid client")
+	case domainErr.ErrInvalidRedirectURI, domainErr.ErrInvalidScope:
+		response.BadRequest(c, "Invalid redirect_uri or scope")
+	case domainErr.ErrInvalidGrant, domainErr.ErrInvalidCodeVerifier,
+		domainErr.ErrInvalidToken, domainErr.ErrTokenRevoked, domainErr.ErrTokenExpired:
+		response.BadRequest(c, "Invalid grant")
+	case domainErr.ErrUnsupportedGrantType:
+		response.BadRequest(c, "Unsupported grant type")
+	default:
+		response.InternalServerError(c, "An error occurred. Please try again")
+	}
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+
+	"auth-service/internal/application/dto"
+	"auth-service/internal/application/usecase"
+	domainErr "auth-service/internal/domain/error"
+	"auth-service/internal/infrastructure/config"
+	"auth-service/internal/infrastructure/logger"
+	"auth-service/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type RoleHandler struct {
+	roleUseCase *usecase.RoleUseCase
+	config      *config.Config
+	logger      *logger.Logger
+}
+
+func NewRoleHandler(roleUseCase *usecase.RoleUseCase, config *config.Config, logger *logger.Logger) *RoleHandler {
+	return &RoleHandler{
+		roleUseCase: roleUseCase,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	roles, err := h.roleUseCase.ListRoles(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result := make([]dto.RoleDTO, len(roles))
+	for i, role := range roles {
+		result[i] = dto.RoleDTO{
+			Name:        role.Name,
+			Parent:      role.Parent,
+			Permissions: role.Permissions,
+			CreatedAt:   role.CreatedAt,
+		}
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"roles": result})
+}
+
+func (h *RoleHandler) CreateRole(c *gin.Context) {
+	var req dto.CreateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	role, err := h.roleUseCase.CreateRole(c.Request.Context(), req.Name, req.Parent, req.Permissions)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusCreated, dto.RoleDTO{
+		Name:        role.Name,
+		Parent:      role.Parent,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+	})
+}
+
+func (h *RoleHandler) DeleteRole(c *gin.Context) {
+	var req dto.DeleteRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request payload")
+		return
+	}
+
+	if err := h.roleUseCase.DeleteRole(c.Request.Context(), req.Name); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *RoleHandler) AssignRole(c *gin.Context) {
+	targetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user id")
+		return
+	}
+	roleName := c.Param("role")
+
+	grantedBy, err := uuid.Parse(c.GetString("userID"))
+	if err != nil {
+		response.BadRequest(c, "Invalid admin id")
+		return
+	}
+
+	if err := h.roleUseCase.AssignRole(c.Request.Context(), targetID, roleName, grantedBy); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response.Success(c, http.StatusOK, gin.H{"message": "Role assigned successfully"})
+}
+
+func (h *RoleHandler) RevokeRole(c *gin.Context) {
+	targetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.BadRequest(c, "Invalid user id")
+		return
+	}
+	roleName := c.Param("role")
+
+	revokedBy, err := uuid.Parse(c.GetString("userID"))
+	if err != nil {
+		response.BadRequest(c, "Invalid admin id")
+		return
+	}
+
+	if err := h.roleUseCase.RevokeRole(c.Request.Context(), targetID, roleName, revokedBy); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *RoleHandler) handleError(c *gin.Context, err error) {
+	h.logger.Error("Role request failed", zap.Error(err))
+
+	switch err {
+	case domainErr.ErrRoleNotFound:
+		response.NotFound(c, "Role not found")
+	case domainErr.ErrRoleAlreadyExists:
+		response.Conflict(c, "Role already exists")
+	case domainErr.ErrRoleAlreadyAssigned:
+		response.Conflict(c, "Role already assigned to user")
+	default:
+		response.InternalServerError(c, "An error occurred. Please try again")
+	}
+}
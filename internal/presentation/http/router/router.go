@@ -1,6 +1,8 @@
 package router
 
 import (
+	"time"
+
 	"auth-service/internal/application/usecase"
 	"auth-service/internal/infrastructure/config"
 	"auth-service/internal/infrastructure/logger"
@@ -12,32 +14,59 @@ import (
 
 type rateLimiter interface {
 	Middleware() gin.HandlerFunc
+	LoginMiddleware() gin.HandlerFunc
 }
 
 type Router struct {
-	authHandler   *handler.AuthHandler
-	healthHandler *handler.HealthHandler
-	tokenService  usecase.TokenService
-	config        *config.Config
-	logger        *logger.Logger
-	rateLimiter   rateLimiter
+	authHandler     *handler.AuthHandler
+	healthHandler   *handler.HealthHandler
+	oauthHandler    *handler.OAuthHandler
+	identityHandler *handler.IdentityHandler
+	roleHandler     *handler.RoleHandler
+	auditLogHandler *handler.AuditLogHandler
+	tokenService    usecase.TokenService
+	config          *config.Config
+	logger          *logger.Logger
+	rateLimiter     rateLimiter
 }
 
 func NewRouter(
 	authHandler *handler.AuthHandler,
 	healthHandler *handler.HealthHandler,
+	oauthHandler *handler.OAuthHandler,
+	identityHandler *handler.IdentityHandler,
+	roleHandler *handler.RoleHandler,
+	auditLogHandler *handler.AuditLogHandler,
 	tokenService usecase.TokenService,
 	config *config.Config,
 	logger *logger.Logger,
 ) *Router {
 	return &Router{
-		authHandler:   authHandler,
-		healthHandler: healthHandler,
-		tokenService:  tokenService,
-		config:        config,
-		logger:        logger,
-		rateLimiter:   middleware.NewRateLimiter(config.Security.RateLimitPerMinute),
+		authHandler:     authHandler,
+		healthHandler:   healthHandler,
+		oauthHandler:    oauthHandler,
+		identityHandler: identityHandler,
+		roleHandler:     roleHandler,
+		auditLogHandler: auditLogHandler,
+		tokenService:    tokenService,
+		config:          config,
+		logger:          logger,
+		rateLimiter:     newRateLimiter(config),
+	}
+}
+
+// newRateLimiter builds the distributed Redis-backed limiter when Redis is
+// configured, falling back to the single-process in-memory one otherwise.
+func newRateLimiter(config *config.Config) rateLimiter {
+	if !config.Redis.Enabled {
+		return middleware.NewRateLimiter(config.Security.RateLimitPerMinute)
 	}
+
+	client := middleware.NewRedisClient(config.Redis.Host, config.Redis.Port, config.Redis.Password, config.Redis.DB)
+	return middleware.NewRedisRateLimiter(client, middleware.Rule{
+		Limit:  config.Security.RateLimitPerMinute,
+		Window: time.Minute,
+	})
 }
 
 func (r *Router) Setup() *gin.Engine {
@@ -48,18 +77,38 @@ func (r *Router) Setup() *gin.Engine {
 	engine := gin.New()
 
 	engine.Use(middleware.RecoveryMiddleware(r.logger))
+	engine.Use(middleware.RequestLogger(r.logger))
 	engine.Use(middleware.LoggerMiddleware(r.logger))
 	engine.Use(middleware.CORSMiddleware(r.config.Security.AllowedOrigins))
 
 	engine.GET("/health", r.healthHandler.Check)
 
+	engine.GET("/.well-known/openid-configuration", r.oauthHandler.Discovery)
+	engine.GET("/.well-known/jwks.json", r.oauthHandler.JWKS)
+
+	oauth2 := engine.Group("/oauth2")
+	{
+		oauth2.GET("/authorize", middleware.AuthMiddleware(r.tokenService), r.oauthHandler.Authorize)
+		oauth2.POST("/token", r.oauthHandler.Token)
+		oauth2.POST("/revoke", r.oauthHandler.Revoke)
+		oauth2.POST("/introspect", r.oauthHandler.Introspect)
+		oauth2.GET("/userinfo", middleware.AuthMiddleware(r.tokenService), r.oauthHandler.UserInfo)
+	}
+
 	api := engine.Group("/api/v1")
 	{
 		auth := api.Group("/auth")
 		{
-			auth.POST("/register", r.rateLimiter.Middleware(), r.authHandler.Register)
-			auth.POST("/login", r.rateLimiter.Middleware(), r.authHandler.Login)
+			auth.POST("/register", r.rateLimiter.LoginMiddleware(), r.authHandler.Register)
+			auth.POST("/login", r.rateLimiter.LoginMiddleware(), r.authHandler.Login)
+			auth.POST("/login/mfa", r.rateLimiter.Middleware(), r.authHandler.VerifyMFA)
+			auth.POST("/webauthn/login/begin", r.rateLimiter.Middleware(), r.authHandler.BeginWebAuthnLogin)
+			auth.POST("/webauthn/login/finish", r.rateLimiter.Middleware(), r.authHandler.FinishWebAuthnLogin)
 			auth.POST("/refresh", r.authHandler.RefreshToken)
+			auth.POST("/verify-email", r.authHandler.VerifyEmail)
+			auth.POST("/verify-email/resend", r.rateLimiter.Middleware(), r.authHandler.ResendVerification)
+			auth.POST("/forgot-password", r.rateLimiter.Middleware(), r.authHandler.ForgotPassword)
+			auth.POST("/reset-password", r.authHandler.ResetPassword)
 
 			protected := auth.Group("")
 			protected.Use(middleware.AuthMiddleware(r.tokenService))
@@ -68,7 +117,47 @@ func (r *Router) Setup() *gin.Engine {
 				protected.POST("/logout", r.authHandler.Logout)
 				protected.POST("/logout-all", r.authHandler.LogoutAll)
 				protected.POST("/change-password", r.authHandler.ChangePassword)
+				protected.POST("/reauthenticate", r.authHandler.Reauthenticate)
+				protected.POST("/mfa/totp", r.authHandler.EnrollMFA)
+				protected.POST("/mfa/totp/confirm", r.authHandler.ConfirmMFA)
+				protected.DELETE("/mfa/totp", r.authHandler.DisableMFA)
+				protected.POST("/webauthn/register/begin", r.authHandler.BeginWebAuthnRegistration)
+				protected.POST("/webauthn/register/finish", r.authHandler.FinishWebAuthnRegistration)
+			}
+
+			auth.GET("/:provider/login", r.identityHandler.Login)
+			auth.GET("/:provider/callback", r.identityHandler.Callback)
+		}
+
+		me := api.Group("/me")
+		me.Use(middleware.AuthMiddleware(r.tokenService))
+		{
+			me.POST("/identities/:provider", r.identityHandler.LinkIdentity)
+			me.DELETE("/identities/:provider", r.identityHandler.UnlinkIdentity)
+		}
+
+		admin := api.Group("/admin")
+		admin.Use(middleware.AuthMiddleware(r.tokenService))
+		{
+			roles := admin.Group("/roles")
+			roles.Use(middleware.RequirePermission("roles.manage"))
+			{
+				roles.GET("", r.roleHandler.ListRoles)
+				roles.POST("", r.roleHandler.CreateRole)
+				roles.DELETE("", r.roleHandler.DeleteRole)
 			}
+
+			users := admin.Group("/users")
+			users.Use(middleware.RequirePermission("roles.manage"))
+			{
+				users.POST("/:id/roles/:role", r.roleHandler.AssignRole)
+				users.DELETE("/:id/roles/:role", r.roleHandler.RevokeRole)
+			}
+
+			auditLogPerm := middleware.RequirePermission("audit.export")
+			admin.GET("/audit-logs", auditLogPerm, r.auditLogHandler.List)
+			admin.GET("/audit-logs.csv", auditLogPerm, r.auditLogHandler.Export)
+			admin.POST("/audit-logs/search", auditLogPerm, r.auditLogHandler.Search)
 		}
 	}
 
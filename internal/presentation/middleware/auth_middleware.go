@@ -4,9 +4,11 @@ import (
 	"strings"
 
 	"auth-service/internal/application/usecase"
+	"auth-service/internal/infrastructure/logger"
 	"auth-service/pkg/response"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 func AuthMiddleware(tokenService usecase.TokenService) gin.HandlerFunc {
@@ -26,14 +28,20 @@ func AuthMiddleware(tokenService usecase.TokenService) gin.HandlerFunc {
 		}
 
 		token := parts[1]
-		userID, err := tokenService.ValidateAccessToken(token)
+		principal, err := tokenService.ValidateAccessToken(token)
 		if err != nil {
 			response.Unauthorized(c, "Invalid or expired token")
 			c.Abort()
 			return
 		}
 
-		c.Set("userID", userID)
+		c.Set("userID", principal.UserID)
+		c.Set("principal", principal)
+
+		reqLogger := logger.FromContext(c.Request.Context()).With(zap.String("user_id", principal.UserID))
+		c.Set("logger", reqLogger)
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context(), reqLogger))
+
 		c.Next()
 	}
 }
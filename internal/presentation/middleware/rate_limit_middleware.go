@@ -48,6 +48,13 @@ func (rl *rateLimiter) cleanup() {
 	}
 }
 
+// LoginMiddleware satisfies the stricter-login-limit half of the rateLimiter
+// interface. This in-memory limiter has no per-route bucketing, so it falls
+// back to the same general per-IP limit as Middleware.
+func (rl *rateLimiter) LoginMiddleware() gin.HandlerFunc {
+	return rl.Middleware()
+}
+
 func (rl *rateLimiter) Middleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		clientIP := c.ClientIP()
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"auth-service/internal/domain/entity"
+	"auth-service/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// principalFromContext reads the Principal stashed by AuthMiddleware. It
+// only returns ok=false if RequireRole/RequirePermission is wired onto a
+// route that skipped AuthMiddleware, which is a routing bug rather than an
+// expected request-time condition.
+func principalFromContext(c *gin.Context) (*entity.Principal, bool) {
+	value, exists := c.Get("principal")
+	if !exists {
+		return nil, false
+	}
+
+	principal, ok := value.(*entity.Principal)
+	return principal, ok
+}
+
+// RequireRole 403s any request whose principal doesn't hold the given role.
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := principalFromContext(c)
+		if !ok || !principal.HasRole(role) {
+			response.Forbidden(c, "Insufficient role")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequirePermission 403s any request whose principal doesn't hold the given
+// dotted permission (e.g. "users.write").
+func RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := principalFromContext(c)
+		if !ok || !principal.HasPermission(permission) {
+			response.Forbidden(c, "Insufficient permissions")
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
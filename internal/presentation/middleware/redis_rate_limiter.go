@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"auth-service/pkg/response"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// keyFunc derives the identity a rate-limit rule is tracked under for a
+// given request, e.g. client IP, IP+route, or the email in a login/register
+// body.
+type keyFunc func(c *gin.Context) string
+
+// KeyByIP keys solely on the client's remote address.
+func KeyByIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// KeyByIPAndRoute keys on client IP scoped to the matched route, so a limit
+// on one endpoint doesn't consume another's budget.
+func KeyByIPAndRoute(c *gin.Context) string {
+	return c.ClientIP() + ":" + c.FullPath()
+}
+
+// KeyByEmailOrIP keys on the "email" field of the JSON request body when
+// present (so clients sharing an IP can't exhaust each other's login
+// attempts), falling back to client IP otherwise. It reads c.Request.Body
+// directly and restores it afterwards, since the downstream handler binds
+// the same body with its own ShouldBindJSON call (ShouldBindBodyWith's
+// cache only serves other ShouldBindBodyWith callers, not that).
+func KeyByEmailOrIP(c *gin.Context) string {
+	bodyBytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return c.ClientIP()
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(bodyBytes, &body); err == nil && body.Email != "" {
+		return "email:" + body.Email
+	}
+	return c.ClientIP()
+}
+
+// Rule is a request quota: Limit requests per Window, tracked per key.
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// slidingWindowScript atomically trims entries older than the window,
+// counts what's left, and (if under the limit) records this request, so
+// concurrent requests across every instance see a consistent count.
+const slidingWindowScript = `
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window_ms = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window_ms)
+local count = redis.call('ZCARD', key)
+if count < limit then
+	redis.call('ZADD', key, now, now .. '-' .. math.random())
+	redis.call('PEXPIRE', key, window_ms)
+	return {1, limit - count - 1}
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+local retry_ms = window_ms
+if oldest[2] ~= nil then
+	retry_ms = window_ms - (now - tonumber(oldest[2]))
+end
+return {0, retry_ms}
+`
+
+// RedisRateLimiter is a distributed sliding-window-log limiter backed by a
+// Redis sorted set per key, so the limit holds across every instance behind
+// the load balancer rather than per-process.
+type RedisRateLimiter struct {
+	client      *redis.Client
+	defaultRule Rule
+}
+
+// NewRedisRateLimiter builds a limiter applying defaultRule, keyed by client
+// IP, to every route unless overridden by RouteMiddleware.
+func NewRedisRateLimiter(client *redis.Client, defaultRule Rule) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client, defaultRule: defaultRule}
+}
+
+// Middleware applies the limiter's default rule, keyed by client IP.
+func (rl *RedisRateLimiter) Middleware() gin.HandlerFunc {
+	return rl.middlewareFor(rl.defaultRule, KeyByIP)
+}
+
+// loginRule is the stricter quota LoginMiddleware enforces on /login and
+// /register, keyed by the submitted email so a shared office IP can't drown
+// out other tenants' login attempts.
+var loginRule = Rule{Limit: 5, Window: 30 * time.Minute}
+
+// LoginMiddleware applies loginRule, keyed by the request's email field
+// (falling back to IP), for the tighter limit credential-stuffing-prone
+// endpoints need.
+func (rl *RedisRateLimiter) LoginMiddleware() gin.HandlerFunc {
+	return rl.middlewareFor(loginRule, KeyByEmailOrIP)
+}
+
+// RouteMiddleware applies a stricter, route-specific rule (e.g. 5 requests
+// per 30 minutes for /login), keyed by key instead of the default.
+func (rl *RedisRateLimiter) RouteMiddleware(rule Rule, key keyFunc) gin.HandlerFunc {
+	return rl.middlewareFor(rule, key)
+}
+
+func (rl *RedisRateLimiter) middlewareFor(rule Rule, key keyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		redisKey := fmt.Sprintf("ratelimit:%s", key(c))
+
+		now := time.Now().UnixMilli()
+		windowMs := rule.Window.Milliseconds()
+
+		result, err := rl.client.Eval(c.Request.Context(), slidingWindowScript, []string{redisKey}, now, windowMs, rule.Limit).Result()
+		if err != nil {
+			// Redis is unreachable: fail open rather than lock every client
+			// out of the API because the limiter's backing store hiccuped.
+			c.Next()
+			return
+		}
+
+		values, ok := result.([]interface{})
+		if !ok || len(values) != 2 {
+			c.Next()
+			return
+		}
+
+		allowed, _ := values[0].(int64)
+		remaining, _ := values[1].(int64)
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(rule.Limit))
+
+		if allowed == 0 {
+			retryAfter := time.Duration(remaining) * time.Millisecond
+			c.Header("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			c.Header("X-RateLimit-Remaining", "0")
+			response.TooManyRequests(c, "Rate limit exceeded. Please try again later")
+			c.Abort()
+			return
+		}
+
+		c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		c.Next()
+	}
+}
+
+// NewRedisClient builds the shared client backing every Redis-backed
+// component (currently just RedisRateLimiter).
+func NewRedisClient(host, port, password string, db int) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     host + ":" + port,
+		Password: password,
+		DB:       db,
+	})
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"auth-service/internal/infrastructure/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RequestLogger attaches a per-request Logger, annotated with
+// {request_id, method, path, ip}, to both the gin context (key "logger")
+// and the request's context.Context (via logger.WithContext), so usecases
+// can log business events correlated to the request that triggered them.
+// AuthMiddleware augments the logger with user_id once the principal is
+// known.
+func RequestLogger(base *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := uuid.Must(uuid.NewV7()).String()
+
+		reqLogger := base.With(
+			zap.String("request_id", requestID),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("ip", c.ClientIP()),
+		)
+
+		c.Set("requestID", requestID)
+		c.Set("logger", reqLogger)
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context(), reqLogger))
+
+		c.Next()
+	}
+}
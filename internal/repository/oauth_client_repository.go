@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"auth-service/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type OAuthClientRepository struct {
+	DB *gorm.DB
+}
+
+func NewOAuthClientRepository(db *gorm.DB) *OAuthClientRepository {
+	return &OAuthClientRepository{DB: db}
+}
+
+func (r *OAuthClientRepository) FindByClientID(clientID string) (*model.OAuthClient, error) {
+	var client model.OAuthClient
+	if err := r.DB.Where("client_id = ?", clientID).First(&client).Error; err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
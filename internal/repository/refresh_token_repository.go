@@ -33,8 +33,36 @@ func (r *RefreshTokenRepository) RevokeByTokenHash(tokenHash string) error {
 		Update("revoked", true).Error
 }
 
+// RevokeAndReplace revokes a token and records which token it was rotated
+// into, so reuse detection can tell "rotated away and replayed" (theft)
+// apart from "revoked by logout and replayed" (stale). It returns
+// gorm.ErrRecordNotFound if the token was already revoked by a concurrent
+// call, so the caller can detect a lost race instead of silently treating
+// it as a successful rotation.
+func (r *RefreshTokenRepository) RevokeAndReplace(tokenHash string, replacedByID uuid.UUID) error {
+	result := r.DB.Model(&model.RefreshToken{}).
+		Where("token = ? AND revoked = FALSE", tokenHash).
+		Updates(map[string]interface{}{"revoked": true, "replaced_by_id": replacedByID})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 func (r *RefreshTokenRepository) RevokeAllByUser(userID uuid.UUID) error {
 	return r.DB.Model(&model.RefreshToken{}).
 		Where("user_id = ? AND revoked = FALSE", userID).
 		Update("revoked", true).Error
 }
+
+// RevokeFamily revokes every token descended from the same login, used
+// when a rotated-away token is presented again (reuse detection): the
+// whole chain is compromised, not just the one token.
+func (r *RefreshTokenRepository) RevokeFamily(familyID uuid.UUID) error {
+	return r.DB.Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked = FALSE", familyID).
+		Update("revoked", true).Error
+}
@@ -35,3 +35,7 @@ func (r *UserRepository) FindUserByID(id uuid.UUID) (*model.User, error) {
 	}
 	return &user, nil
 }
+
+func (r *UserRepository) Update(user *model.User) error {
+	return r.DB.Save(user).Error
+}
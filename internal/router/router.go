@@ -5,25 +5,36 @@ import (
 
 	"auth-service/config"
 	"auth-service/internal/controller"
+	domainSecurity "auth-service/internal/domain/security"
 	"auth-service/internal/middleware"
+	"auth-service/internal/repository"
 	"auth-service/internal/service"
 )
 
-func SetupRouter(authService *service.AuthService, cfg *config.Config) *gin.Engine {
+func SetupRouter(authService *service.AuthService, cfg *config.Config, oauthClientRepo *repository.OAuthClientRepository, passwordHasher domainSecurity.PasswordHasher) *gin.Engine {
 	r := gin.Default()
 
 	r.GET("/health", controller.CheckHealth(authService))
 	r.POST("/register", controller.Register(authService))
 	r.POST("/login", controller.Login(authService, cfg))
 	r.POST("/refresh", controller.Refresh(authService, cfg))
+	r.GET("/.well-known/jwks.json", controller.JWKS(authService.Keys))
 
 	// Protected routes
 	auth := r.Group("/")
-	auth.Use(middleware.AuthMiddleware(authService.JWTSecret))
+	auth.Use(middleware.AuthMiddleware(authService.Keys, authService.Denylist))
 
 	auth.GET("/me", controller.GetMe(authService))
 	auth.POST("/logout", controller.Logout(authService, cfg))        // current session
 	auth.POST("/logout-all", controller.LogoutAll(authService, cfg)) // all sessions
+	auth.POST("/admin/keys/rotate", middleware.RequireAdmin(authService.UserRepo), controller.RotateSigningKey(authService.Keys))
+
+	// Client-authenticated routes (RFC 7662 / RFC 7009), for other services
+	// validating and revoking tokens this service issued.
+	oauthClients := r.Group("/oauth")
+	oauthClients.Use(middleware.RequireClientAuth(oauthClientRepo, passwordHasher))
+	oauthClients.POST("/introspect", controller.Introspect(authService))
+	oauthClients.POST("/revoke", controller.Revoke(authService))
 
 	return r
 }
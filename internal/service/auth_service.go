@@ -1,52 +1,68 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
 	"time"
 
-	"golang.org/x/crypto/bcrypt"
-
+	domainSecurity "auth-service/internal/domain/security"
 	"auth-service/internal/model"
 	"auth-service/internal/repository"
 	"auth-service/utils"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
+// ErrRefreshReuseDetected is returned by Refresh when a token that has
+// already been rotated away is presented again, meaning it leaked: the
+// whole family has just been revoked, not only the one token.
+var ErrRefreshReuseDetected = errors.New("refresh token reuse detected; session revoked")
+
 type AuthService struct {
-	UserRepo    *repository.UserRepository
-	RefreshRepo *repository.RefreshTokenRepository
-	JWTSecret   string
-	AccessTTL   time.Duration
-	RefreshTTL  time.Duration
+	UserRepo       *repository.UserRepository
+	RefreshRepo    *repository.RefreshTokenRepository
+	Keys           *utils.KeyManager
+	Denylist       *utils.TokenDenylist // nil disables mid-TTL access token revocation
+	PasswordHasher domainSecurity.PasswordHasher
+	AccessTTL      time.Duration
+	RefreshTTL     time.Duration // hard cap on a refresh token family's lifetime
+	IdleTimeout    time.Duration // sliding expiry extension granted on each use
 }
 
 func NewAuthService(
 	userRepo *repository.UserRepository,
 	refreshRepo *repository.RefreshTokenRepository,
-	jwtSecret string,
+	keys *utils.KeyManager,
+	denylist *utils.TokenDenylist,
+	passwordHasher domainSecurity.PasswordHasher,
 	accessTTL time.Duration,
 	refreshTTL time.Duration,
+	idleTimeout time.Duration,
 ) *AuthService {
 	return &AuthService{
-		UserRepo:    userRepo,
-		RefreshRepo: refreshRepo,
-		JWTSecret:   jwtSecret,
-		AccessTTL:   accessTTL,
-		RefreshTTL:  refreshTTL,
+		UserRepo:       userRepo,
+		RefreshRepo:    refreshRepo,
+		Keys:           keys,
+		Denylist:       denylist,
+		PasswordHasher: passwordHasher,
+		AccessTTL:      accessTTL,
+		RefreshTTL:     refreshTTL,
+		IdleTimeout:    idleTimeout,
 	}
 }
 
 func (s *AuthService) Register(email, password string) error {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := s.PasswordHasher.Hash(password)
 	if err != nil {
 		return err
 	}
 
 	user := &model.User{
 		Email:    email,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
 	}
 
 	return s.UserRepo.CreateUser(user)
@@ -57,26 +73,37 @@ func (s *AuthService) Login(email, password string) (string, string, error) {
 	if err != nil {
 		return "", "", errors.New("user not found")
 	}
-	if bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) != nil {
+	ok, err := s.PasswordHasher.Verify(user.Password, password)
+	if err != nil || !ok {
 		return "", "", errors.New("invalid credentials")
 	}
 
+	if s.PasswordHasher.NeedsRehash(user.Password) {
+		if rehashed, err := s.PasswordHasher.Hash(password); err == nil {
+			user.Password = rehashed
+			_ = s.UserRepo.Update(user)
+		}
+	}
+
 	// Access token (short)
-	accessToken, err := utils.GenerateToken(user.ID.String(), s.JWTSecret, s.AccessTTL)
+	accessToken, err := s.Keys.SignToken(user.ID.String(), s.AccessTTL)
 	if err != nil {
 		return "", "", err
 	}
 
-	// Refresh token (long, store HASH)
+	// Refresh token (long, store HASH) — first token in a new family
 	refreshPlain, refreshHash, err := utils.GenerateRefreshToken()
 	if err != nil {
 		return "", "", err
 	}
+	now := time.Now()
+	hardExpiresAt := now.Add(s.RefreshTTL)
 	rt := &model.RefreshToken{
-		UserID:    user.ID,
-		Token:     refreshHash, // store HASH, NOT plain
-		ExpiresAt: time.Now().Add(s.RefreshTTL),
-		Revoked:   false,
+		UserID:        user.ID,
+		Token:         refreshHash, // store HASH, NOT plain
+		ExpiresAt:     s.slidingExpiry(now, hardExpiresAt),
+		HardExpiresAt: hardExpiresAt,
+		Revoked:       false,
 	}
 	if err := s.RefreshRepo.Create(rt); err != nil {
 		return "", "", err
@@ -95,7 +122,24 @@ func (s *AuthService) Refresh(refreshPlain string, userIDExpected string) (strin
 	if err != nil {
 		return "", "", errors.New("invalid refresh token")
 	}
-	if rt.Revoked || time.Now().After(rt.ExpiresAt) {
+
+	if rt.Revoked {
+		if !rt.WasReplaced() {
+			// Revoked by logout or some other ordinary action, not by
+			// rotation: a stale replay, not evidence of theft. Don't treat
+			// it as a reuse signal or it'd nuke the family on a token the
+			// user themselves already logged out.
+			return "", "", errors.New("refresh token expired or revoked")
+		}
+		// Already rotated away and presented again: assume it leaked and
+		// kill the whole family, not just this one token.
+		if revokeErr := s.RefreshRepo.RevokeFamily(rt.FamilyID); revokeErr != nil {
+			log.Printf("auth: failed to revoke token family %s after reuse detection: %v", rt.FamilyID, revokeErr)
+		}
+		log.Printf("auth: refresh token reuse detected, family %s revoked for user %s", rt.FamilyID, rt.UserID)
+		return "", "", ErrRefreshReuseDetected
+	}
+	if time.Now().After(rt.ExpiresAt) {
 		return "", "", errors.New("refresh token expired or revoked")
 	}
 	// Optional: enforce refresh belongs to the same user (defense-in-depth)
@@ -103,11 +147,8 @@ func (s *AuthService) Refresh(refreshPlain string, userIDExpected string) (strin
 		return "", "", errors.New("refresh token does not belong to user")
 	}
 
-	// Rotate: revoke old
-	_ = s.RefreshRepo.RevokeByTokenHash(refreshHash)
-
-	// Issue new pair
-	newAccess, err := utils.GenerateToken(rt.UserID.String(), s.JWTSecret, s.AccessTTL)
+	// Issue new pair, inheriting the family and its hard cap
+	newAccess, err := s.Keys.SignToken(rt.UserID.String(), s.AccessTTL)
 	if err != nil {
 		return "", "", err
 	}
@@ -116,28 +157,66 @@ func (s *AuthService) Refresh(refreshPlain string, userIDExpected string) (strin
 		return "", "", err
 	}
 	newRT := &model.RefreshToken{
-		UserID:    rt.UserID,
-		Token:     newRefreshHash,
-		ExpiresAt: time.Now().Add(s.RefreshTTL),
-		Revoked:   false,
+		UserID:        rt.UserID,
+		FamilyID:      rt.FamilyID,
+		Token:         newRefreshHash,
+		ExpiresAt:     s.slidingExpiry(time.Now(), rt.HardExpiresAt),
+		HardExpiresAt: rt.HardExpiresAt,
+		Revoked:       false,
 	}
 	if err := s.RefreshRepo.Create(newRT); err != nil {
 		return "", "", err
 	}
 
+	// Rotate: revoke old, linking it to its successor so a later replay of
+	// the old token is recognized as rotation (and thus reuse), not logout.
+	if err := s.RefreshRepo.RevokeAndReplace(refreshHash, newRT.ID); err != nil {
+		// Someone else (a concurrent Refresh, or a logout) revoked the old
+		// token first: this call lost the race. Don't hand back the
+		// already-created newRT as if rotation succeeded — revoke it too,
+		// or it would be a live, un-rotated-away credential nobody knows about.
+		if revokeErr := s.RefreshRepo.RevokeByTokenHash(newRefreshHash); revokeErr != nil {
+			log.Printf("auth: failed to revoke orphaned refresh token for user %s: %v", rt.UserID, revokeErr)
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", "", errors.New("refresh token expired or revoked")
+		}
+		return "", "", err
+	}
+
 	return newAccess, newRefreshPlain, nil
 }
 
-func (s *AuthService) LogoutCurrent(refreshPlain string) error {
+// slidingExpiry extends a refresh token's expiry by IdleTimeout from now,
+// never past hardCap, so an active session keeps renewing while an idle
+// one still expires on schedule.
+func (s *AuthService) slidingExpiry(now, hardCap time.Time) time.Time {
+	idle := now.Add(s.IdleTimeout)
+	if idle.After(hardCap) {
+		return hardCap
+	}
+	return idle
+}
+
+// LogoutCurrent revokes the presented refresh token and, if jti is known
+// (set by AuthMiddleware from the access token the request carried),
+// denylists that access token too so it stops working immediately instead
+// of lingering until its TTL.
+func (s *AuthService) LogoutCurrent(refreshPlain, jti string, tokenExpiresAt time.Time) error {
 	if refreshPlain == "" {
 		return errors.New("missing refresh token")
 	}
 	refreshHash := utils.HashRefreshToken(refreshPlain)
-	return s.RefreshRepo.RevokeByTokenHash(refreshHash)
+	if err := s.RefreshRepo.RevokeByTokenHash(refreshHash); err != nil {
+		return err
+	}
+	s.denylistAccessToken(jti, tokenExpiresAt)
+	return nil
 }
 
-// Logout revokes ALL refresh tokens of the user
-func (s *AuthService) Logout(userID string) error {
+// Logout revokes ALL refresh tokens of the user and denylists the access
+// token the request carried.
+func (s *AuthService) Logout(userID, jti string, tokenExpiresAt time.Time) error {
 	if s.RefreshRepo == nil {
 		return fmt.Errorf("refresh token repository not initialized")
 	}
@@ -145,7 +224,81 @@ func (s *AuthService) Logout(userID string) error {
 	if err != nil {
 		return fmt.Errorf("invalid user id: %w", err)
 	}
-	return s.RefreshRepo.RevokeAllByUser(id)
+	if err := s.RefreshRepo.RevokeAllByUser(id); err != nil {
+		return err
+	}
+	s.denylistAccessToken(jti, tokenExpiresAt)
+	return nil
+}
+
+func (s *AuthService) denylistAccessToken(jti string, expiresAt time.Time) {
+	if s.Denylist == nil || jti == "" {
+		return
+	}
+	if err := s.Denylist.Revoke(context.Background(), jti, expiresAt); err != nil {
+		log.Printf("auth: failed to denylist jti %s: %v", jti, err)
+	}
+}
+
+// IntrospectionResult is the RFC 7662 token state AuthService resolved a
+// token to. Subject/ExpiresAt/IssuedAt/TokenType/Scope are only meaningful
+// when Active is true.
+type IntrospectionResult struct {
+	Active    bool
+	Subject   string
+	IssuedAt  int64
+	ExpiresAt int64
+	TokenType string
+	Scope     string
+}
+
+// Introspect resolves token per RFC 7662, trying it as an access token
+// first unless tokenTypeHint says "refresh_token". An expired, revoked, or
+// unrecognized token all collapse to the same {Active: false}, so a caller
+// can't distinguish them and probe for which kind of invalid token it holds.
+func (s *AuthService) Introspect(token, tokenTypeHint string) IntrospectionResult {
+	if tokenTypeHint != "refresh_token" {
+		if claims, err := s.Keys.VerifyToken(token); err == nil {
+			if s.Denylist != nil && s.Denylist.IsRevoked(context.Background(), claims.JTI) {
+				return IntrospectionResult{Active: false}
+			}
+			return IntrospectionResult{
+				Active:    true,
+				Subject:   claims.UserID,
+				IssuedAt:  claims.IssuedAt.Unix(),
+				ExpiresAt: claims.ExpiresAt.Unix(),
+				TokenType: "access_token",
+			}
+		}
+	}
+
+	refreshHash := utils.HashRefreshToken(token)
+	rt, err := s.RefreshRepo.FindByTokenHash(refreshHash)
+	if err != nil || rt.Revoked || time.Now().After(rt.ExpiresAt) {
+		return IntrospectionResult{Active: false}
+	}
+	return IntrospectionResult{
+		Active:    true,
+		Subject:   rt.UserID.String(),
+		IssuedAt:  rt.CreatedAt.Unix(),
+		ExpiresAt: rt.ExpiresAt.Unix(),
+		TokenType: "refresh_token",
+	}
+}
+
+// Revoke resolves token per RFC 7009: a refresh token is marked revoked in
+// the repository, an access token's jti is denylisted until its natural
+// expiry. It never reports whether token was valid, already revoked, or
+// unknown, since the spec treats all three as a successful no-op.
+func (s *AuthService) Revoke(token, tokenTypeHint string) {
+	if tokenTypeHint != "refresh_token" {
+		if claims, err := s.Keys.VerifyToken(token); err == nil {
+			s.denylistAccessToken(claims.JTI, claims.ExpiresAt)
+			return
+		}
+	}
+	refreshHash := utils.HashRefreshToken(token)
+	_ = s.RefreshRepo.RevokeByTokenHash(refreshHash)
 }
 
 func (s *AuthService) GetMe(userID string) (*model.User, error) {
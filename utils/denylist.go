@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TokenDenylist lets still-valid access tokens be revoked before their
+// natural expiry, by jti, so /logout and /logout-all take effect
+// immediately instead of waiting out the access token's TTL.
+type TokenDenylist struct {
+	client *redis.Client
+}
+
+func NewTokenDenylist(client *redis.Client) *TokenDenylist {
+	return &TokenDenylist{client: client}
+}
+
+// Revoke denylists jti until expiresAt, after which the token would have
+// expired naturally anyway and the entry can be dropped.
+func (d *TokenDenylist) Revoke(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return d.client.Set(ctx, denylistKey(jti), "1", ttl).Err()
+}
+
+// IsRevoked reports whether jti has been revoked. Redis errors are treated
+// as "not revoked" so an outage degrades to pre-denylist behavior (tokens
+// stay valid until their TTL) rather than locking every user out.
+func (d *TokenDenylist) IsRevoked(ctx context.Context, jti string) bool {
+	n, err := d.client.Exists(ctx, denylistKey(jti)).Result()
+	return err == nil && n > 0
+}
+
+func denylistKey(jti string) string {
+	return "jwt:denylist:" + jti
+}
@@ -1,55 +0,0 @@
-package utils
-
-import (
-	"errors"
-	"time"
-
-	"github.com/golang-jwt/jwt/v5"
-)
-
-func GenerateToken(userID string, secret string, duration time.Duration) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"exp":     time.Now().Add(duration).Unix(),
-	}
-
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
-}
-
-// ParseToken validates a JWT token string and returns the embedded user_id.
-func ParseToken(tokenString string, secret string) (string, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Ensure the token is signed with HS256
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(secret), nil
-	}, jwt.WithValidMethods([]string{"HS256"}))
-	if err != nil {
-		return "", err
-	}
-
-	if !token.Valid {
-		return "", errors.New("invalid token")
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return "", errors.New("invalid claims")
-	}
-
-	// Verify exp is valid (manually check exp if present)
-	if expVal, ok := claims["exp"].(float64); ok {
-		if time.Unix(int64(expVal), 0).Before(time.Now()) {
-			return "", errors.New("token expired")
-		}
-	}
-
-	userID, _ := claims["user_id"].(string)
-	if userID == "" {
-		return "", errors.New("user_id missing in token")
-	}
-
-	return userID, nil
-}
@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// KeyManager signs access tokens with RS256 and rotates the signing key on
+// a schedule, keeping retired keys around for Grace so tokens signed just
+// before a rotation still verify. kid is the SHA-256 fingerprint of the
+// public key, carried in the token's "kid" header so JWKS consumers (and
+// ParseToken, after a rotation) know which key to check a signature
+// against.
+type KeyManager struct {
+	mu          sync.RWMutex
+	storagePath string
+	grace       time.Duration
+	current     *signingKey
+	retired     []*signingKey
+}
+
+type signingKey struct {
+	kid        string
+	privateKey *rsa.PrivateKey
+	createdAt  time.Time
+}
+
+// NewKeyManager loads the signing key from storagePath if present, otherwise
+// generates a new one and persists it there. storagePath may be empty, in
+// which case the key lives in memory only (fine for local dev, not for a
+// multi-replica deployment).
+func NewKeyManager(storagePath string, grace time.Duration) (*KeyManager, error) {
+	km := &KeyManager{storagePath: storagePath, grace: grace}
+
+	if storagePath != "" {
+		if data, err := os.ReadFile(storagePath); err == nil {
+			key, err := parseRSAPrivateKeyPEM(data)
+			if err != nil {
+				return nil, err
+			}
+			km.current = newSigningKey(key)
+			return km, nil
+		}
+	}
+
+	if err := km.RotateKey(); err != nil {
+		return nil, err
+	}
+	return km, nil
+}
+
+func newSigningKey(key *rsa.PrivateKey) *signingKey {
+	return &signingKey{kid: kidFor(&key.PublicKey), privateKey: key, createdAt: time.Now()}
+}
+
+// RotateKey generates a fresh signing key and makes it current, retiring
+// the previous one into the grace-period set instead of discarding it.
+func (km *KeyManager) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+
+	km.mu.Lock()
+	if km.current != nil {
+		km.retired = append(km.retired, km.current)
+	}
+	km.current = newSigningKey(key)
+	km.mu.Unlock()
+
+	if km.storagePath != "" {
+		if err := os.MkdirAll(filepath.Dir(km.storagePath), 0o700); err != nil {
+			return err
+		}
+		if err := os.WriteFile(km.storagePath, encodeRSAPrivateKeyPEM(key), 0o600); err != nil {
+			return err
+		}
+	}
+
+	km.PruneExpired()
+	return nil
+}
+
+// PruneExpired drops retired keys whose grace period has elapsed.
+func (km *KeyManager) PruneExpired() {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	fresh := km.retired[:0]
+	for _, k := range km.retired {
+		if time.Since(k.createdAt) < km.grace {
+			fresh = append(fresh, k)
+		}
+	}
+	km.retired = fresh
+}
+
+// Claims is what VerifyToken hands back once a token's signature and
+// expiry check out. JTI is the token's unique ID, used to key it into the
+// denylist for mid-TTL revocation.
+type Claims struct {
+	UserID    string
+	JTI       string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// SignToken issues an RS256 access token carrying the current key's kid and
+// a random jti, so a still-valid token can later be revoked individually.
+func (km *KeyManager) SignToken(userID string, ttl time.Duration) (string, error) {
+	km.mu.RLock()
+	current := km.current
+	km.mu.RUnlock()
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"user_id": userID,
+		"jti":     uuid.NewString(),
+		"iat":     now.Unix(),
+		"exp":     now.Add(ttl).Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = current.kid
+	return token.SignedString(current.privateKey)
+}
+
+// VerifyToken parses tokenString and selects the verification key by its
+// kid header, checking both the current key and any still within their
+// grace period. It does not consult a denylist; callers needing mid-TTL
+// revocation should check the returned JTI against one themselves.
+func (km *KeyManager) VerifyToken(tokenString string) (*Claims, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		key := km.publicKeyForKid(kid)
+		if key == nil {
+			return nil, errors.New("unknown signing key")
+		}
+		return key, nil
+	}, jwt.WithValidMethods([]string{"RS256"}))
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid claims")
+	}
+
+	expVal, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, errors.New("exp missing in token")
+	}
+	expiresAt := time.Unix(int64(expVal), 0)
+	if expiresAt.Before(time.Now()) {
+		return nil, errors.New("token expired")
+	}
+
+	userID, _ := claims["user_id"].(string)
+	if userID == "" {
+		return nil, errors.New("user_id missing in token")
+	}
+
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return nil, errors.New("jti missing in token")
+	}
+
+	var issuedAt time.Time
+	if iatVal, ok := claims["iat"].(float64); ok {
+		issuedAt = time.Unix(int64(iatVal), 0)
+	}
+
+	return &Claims{UserID: userID, JTI: jti, IssuedAt: issuedAt, ExpiresAt: expiresAt}, nil
+}
+
+func (km *KeyManager) publicKeyForKid(kid string) *rsa.PublicKey {
+	km.mu.RLock()
+	defer km.mu.RUnlock()
+
+	if km.current.kid == kid {
+		return &km.current.privateKey.PublicKey
+	}
+	for _, k := range km.retired {
+		if k.kid == kid {
+			return &k.privateKey.PublicKey
+		}
+	}
+	return nil
+}
+
+// JWK is the JSON Web Key representation of one RSA public key, as served
+// by GET /.well-known/jwks.json.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKS returns every public key a client might still need to verify a
+// signature with: the current one plus any still in their grace period.
+func (km *KeyManager) JWKS() []JWK {
+	km.mu.RLock()
+	defer km.mu.RUnlock()
+
+	keys := make([]JWK, 0, len(km.retired)+1)
+	keys = append(keys, jwkFor(km.current))
+	for _, k := range km.retired {
+		keys = append(keys, jwkFor(k))
+	}
+	return keys
+}
+
+func jwkFor(k *signingKey) JWK {
+	pub := k.privateKey.PublicKey
+	return JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: k.kid,
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+func kidFor(pub *rsa.PublicKey) string {
+	sum := sha256.Sum256(pub.N.Bytes())
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+func parseRSAPrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM key file")
+	}
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+func encodeRSAPrivateKeyPEM(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+}
@@ -0,0 +1,17 @@
+package utils
+
+import "github.com/redis/go-redis/v9"
+
+// NewRedisClient builds the client backing TokenDenylist. Returns nil if
+// host is empty, so callers can pass it straight through without a nil
+// check of their own.
+func NewRedisClient(host, port, password string, db int) *redis.Client {
+	if host == "" {
+		return nil
+	}
+	return redis.NewClient(&redis.Options{
+		Addr:     host + ":" + port,
+		Password: password,
+		DB:       db,
+	})
+}